@@ -2,12 +2,16 @@ package micron
 
 import (
 	"context"
+	"errors"
 	"log/slog"
+	"sync/atomic"
+	"time"
 
 	"github.com/zalgonoise/cfg"
 	"github.com/zalgonoise/x/errs"
 	"go.opentelemetry.io/otel/trace"
 
+	"github.com/zalgonoise/micron/executor"
 	"github.com/zalgonoise/micron/selector"
 )
 
@@ -40,12 +44,44 @@ type Runtime interface {
 	// define when should the cron Runtime be halted, for example with context cancellation or timeout.
 	//
 	// Any error raised within a Run cycle is channeled to the Runtime errors channel, accessible with the Err method.
+	//
+	// Run returns once the selector.Selector reports that none of its tasks have further executions.
+	//
+	// If configured with a start delay, Run waits for it before selecting any tasks.
+	//
+	// If configured with a maximum number of runs, Run returns once the selector.Selector's Next method succeeds that
+	// many times.
+	//
+	// If configured to stop on error, Run returns after channeling the first error raised by the selector.Selector.
+	//
+	// If configured with a drain timeout, Run waits up to that duration for any in-flight executions before returning.
 	Run(ctx context.Context)
+	// RunOnce executes a single Run cycle using the input context.Context, by calling the selector.Selector's Next method
+	// once, and returning its error directly instead of channeling it to the Runtime errors channel.
+	//
+	// This is a blocking call, useful for one-off executions like tests or "run now" commands.
+	RunOnce(ctx context.Context) error
 	// Err returns a receive-only errors channel, allowing the caller to consumer any errors raised during the execution
 	// of cron jobs.
 	//
 	// It is the responsibility of the caller to consume these errors appropriately, within the logic of their app.
 	Err() <-chan error
+	// Results returns a receive-only channel of the executor.ExecResult of each execution completed by the Runtime's
+	// tasks, with their ID, start time, duration and error (if any).
+	//
+	// The channel has the same capacity as the errors channel, and results are dropped if it is full, so a Runtime is
+	// never blocked by a caller that does not consume them.
+	Results() <-chan executor.ExecResult
+	// IsRunning returns true if the Runtime is in its Run loop. It is safe to call concurrently with Run.
+	IsRunning() bool
+	// Runs returns the number of successful selections in the Runtime's latest (or current) Run call. It is safe to
+	// call concurrently with Run.
+	Runs() int
+	// Schedule returns the ID and next execution time of each of the Runtime's tasks, sorted by their next execution
+	// time. Tasks without further occurrences are listed last, with a zero time.
+	//
+	// This call does not trigger any execution.
+	Schedule(ctx context.Context) []selector.JobSchedule
 }
 
 // Metrics describes the actions that register Runtime-related metrics.
@@ -54,10 +90,25 @@ type Metrics interface {
 	IsUp(bool)
 }
 
+// drainer describes a selector.Selector that is able to wait for its in-flight executions to complete, like the
+// non-blocking selector.Selector.
+type drainer interface {
+	Drain(ctx context.Context) []string
+}
+
 type runtime struct {
 	sel selector.Selector
 
-	err chan error
+	err     chan error
+	results chan executor.ExecResult
+
+	running *atomic.Bool
+	runs    *atomic.Int64
+
+	stopOnError  bool
+	maxRuns      int
+	drainTimeout time.Duration
+	startDelay   time.Duration
 
 	logger  *slog.Logger
 	metrics Metrics
@@ -70,31 +121,144 @@ type runtime struct {
 // define when should the cron Runtime be halted, for example with context cancellation or timeout.
 //
 // Any error raised within a Run cycle is channeled to the Runtime errors channel, accessible with the Err method.
+//
+// Run returns once the selector.Selector reports that none of its tasks have further executions.
+//
+// If configured with a start delay, Run waits for it before selecting any tasks.
+//
+// If configured with a maximum number of runs, Run returns once the selector.Selector's Next method succeeds that
+// many times.
+//
+// If configured to stop on error, Run returns after channeling the first error raised by the selector.Selector.
+//
+// If configured with a drain timeout, Run waits up to that duration for any in-flight executions before returning.
 func (r runtime) Run(ctx context.Context) {
 	ctx, span := r.tracer.Start(ctx, "Runtime.Run")
 	defer span.End()
 
+	ctx = executor.WithResultHandler(ctx, r.report)
+
 	r.logger.InfoContext(ctx, "starting cron")
+	r.running.Store(true)
+	r.runs.Store(0)
 	r.metrics.IsUp(true)
 
 	defer func() {
+		r.drain(ctx, span)
 		r.logger.InfoContext(ctx, "closing cron")
+		r.running.Store(false)
 		r.metrics.IsUp(false)
 		span.AddEvent("closing runtime")
 	}()
 
+	if !r.delayStart(ctx, span) {
+		return
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
 			return
 		default:
 			if err := r.sel.Next(ctx); err != nil {
+				// the selector has no tasks left with further executions (e.g. `@reboot` tasks that already ran)
+				if errors.Is(err, selector.ErrExhaustedExecutorsList) {
+					r.logger.InfoContext(ctx, "no further tasks to execute")
+
+					return
+				}
+
 				r.err <- err
+
+				if r.stopOnError {
+					span.RecordError(err)
+					r.logger.ErrorContext(ctx, "stopping cron on error", slog.String("error", err.Error()))
+
+					return
+				}
+
+				continue
+			}
+
+			if r.reachedMaxRuns(r.runs.Add(1)) {
+				span.AddEvent("reached the maximum number of runs")
+				r.logger.InfoContext(ctx, "reached the maximum number of runs", slog.Int("max_runs", r.maxRuns))
+
+				return
 			}
 		}
 	}
 }
 
+// reachedMaxRuns returns true if the Runtime is configured with a maximum number of runs, and the input number of
+// runs has reached it.
+func (r runtime) reachedMaxRuns(runs int64) bool {
+	return r.maxRuns > 0 && runs >= int64(r.maxRuns)
+}
+
+// RunOnce executes a single Run cycle using the input context.Context, by calling the selector.Selector's Next method
+// once, and returning its error directly instead of channeling it to the Runtime errors channel.
+//
+// This is a blocking call, useful for one-off executions like tests or "run now" commands.
+func (r runtime) RunOnce(ctx context.Context) error {
+	ctx, span := r.tracer.Start(ctx, "Runtime.RunOnce")
+	defer span.End()
+
+	r.logger.InfoContext(ctx, "running cron once")
+
+	ctx = executor.WithResultHandler(ctx, r.report)
+
+	return r.sel.Next(ctx)
+}
+
+// delayStart waits for the configured start delay, returning false if the input context.Context is done before it
+// elapses.
+func (r runtime) delayStart(ctx context.Context, span trace.Span) bool {
+	if r.startDelay <= 0 {
+		return true
+	}
+
+	span.AddEvent("delaying start")
+	r.logger.InfoContext(ctx, "delaying the start of the cron", slog.Duration("delay", r.startDelay))
+
+	timer := time.NewTimer(r.startDelay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// drain waits up to the configured drain timeout for the selector.Selector's in-flight executions to complete, logging
+// the tasks that did not finish in time.
+func (r runtime) drain(ctx context.Context, span trace.Span) {
+	if r.drainTimeout <= 0 {
+		return
+	}
+
+	d, ok := r.sel.(drainer)
+	if !ok {
+		return
+	}
+
+	// the input context is likely already cancelled, but its values (like the span) are still relevant
+	drainCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), r.drainTimeout)
+	defer cancel()
+
+	r.logger.InfoContext(ctx, "draining in-flight tasks", slog.Duration("timeout", r.drainTimeout))
+
+	if pending := d.Drain(drainCtx); len(pending) > 0 {
+		span.AddEvent("drain timed out")
+		r.logger.WarnContext(ctx, "in-flight tasks did not finish within the drain timeout",
+			slog.Duration("timeout", r.drainTimeout),
+			slog.Any("ids", pending),
+		)
+	}
+}
+
 // Err returns a receive-only errors channel, allowing the caller to consumer any errors raised during the execution
 // of cron jobs.
 //
@@ -103,6 +267,42 @@ func (r runtime) Err() <-chan error {
 	return r.err
 }
 
+// Results returns a receive-only channel of the executor.ExecResult of each execution completed by the Runtime's
+// tasks, with their ID, start time, duration and error (if any).
+//
+// The channel has the same capacity as the errors channel, and results are dropped if it is full, so a Runtime is
+// never blocked by a caller that does not consume them.
+func (r runtime) Results() <-chan executor.ExecResult {
+	return r.results
+}
+
+// report sends the input executor.ExecResult to the Runtime's results channel, dropping it if the channel is full.
+func (r runtime) report(result executor.ExecResult) {
+	select {
+	case r.results <- result:
+	default:
+	}
+}
+
+// IsRunning returns true if the Runtime is in its Run loop. It is safe to call concurrently with Run.
+func (r runtime) IsRunning() bool {
+	return r.running.Load()
+}
+
+// Runs returns the number of successful selections in the Runtime's latest (or current) Run call. It is safe to
+// call concurrently with Run.
+func (r runtime) Runs() int {
+	return int(r.runs.Load())
+}
+
+// Schedule returns the ID and next execution time of each of the Runtime's tasks, sorted by their next execution
+// time. Tasks without further occurrences are listed last, with a zero time.
+//
+// This call does not trigger any execution.
+func (r runtime) Schedule(ctx context.Context) []selector.JobSchedule {
+	return r.sel.Schedule(ctx)
+}
+
 // New creates a Runtime with the input cfg.Option(s), also returning an error if raised.
 //
 // The minimum requirements to create a Runtime is to supply either a selector.Selector through the WithSelector option,
@@ -135,8 +335,17 @@ func newRuntime(config *Config) (Runtime, error) {
 	}
 
 	return runtime{
-		sel: config.sel,
-		err: make(chan error, size),
+		sel:     config.sel,
+		err:     make(chan error, size),
+		results: make(chan executor.ExecResult, size),
+
+		running: &atomic.Bool{},
+		runs:    &atomic.Int64{},
+
+		stopOnError:  config.stopOnError,
+		maxRuns:      config.maxRuns,
+		drainTimeout: config.drainTimeout,
+		startDelay:   config.startDelay,
 
 		logger:  slog.New(config.handler),
 		metrics: config.metrics,
@@ -156,6 +365,13 @@ type noOpRuntime struct{}
 // This is a no-op call and has no effect.
 func (noOpRuntime) Run(context.Context) {}
 
+// RunOnce executes a single Run cycle using the input context.Context.
+//
+// This is a no-op call and the returned error is always nil.
+func (noOpRuntime) RunOnce(context.Context) error {
+	return nil
+}
+
 // Err returns a receive-only errors channel, allowing the caller to consumer any errors raised during the execution
 // of cron jobs.
 //
@@ -163,3 +379,32 @@ func (noOpRuntime) Run(context.Context) {}
 func (noOpRuntime) Err() <-chan error {
 	return nil
 }
+
+// Results returns a receive-only channel of the executor.ExecResult of each execution completed by the Runtime's
+// tasks.
+//
+// This is a no-op call and the returned receive-only channel is always nil.
+func (noOpRuntime) Results() <-chan executor.ExecResult {
+	return nil
+}
+
+// IsRunning returns true if the Runtime is in its Run loop.
+//
+// This is a no-op call and the returned value is always false.
+func (noOpRuntime) IsRunning() bool {
+	return false
+}
+
+// Runs returns the number of successful selections in the Runtime's latest (or current) Run call.
+//
+// This is a no-op call and the returned value is always zero.
+func (noOpRuntime) Runs() int {
+	return 0
+}
+
+// Schedule returns the ID and next execution time of each of the Runtime's tasks.
+//
+// This is a no-op call and the returned slice is always nil.
+func (noOpRuntime) Schedule(context.Context) []selector.JobSchedule {
+	return nil
+}
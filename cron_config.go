@@ -2,6 +2,7 @@ package micron
 
 import (
 	"log/slog"
+	"time"
 
 	"github.com/zalgonoise/cfg"
 	"go.opentelemetry.io/otel/trace"
@@ -21,6 +22,10 @@ const (
 
 type Config struct {
 	errBufferSize int
+	stopOnError   bool
+	maxRuns       int
+	drainTimeout  time.Duration
+	startDelay    time.Duration
 
 	sel   selector.Selector
 	execs []executor.Executor
@@ -94,7 +99,7 @@ func WithJob(id, cron string, runners ...executor.Runner) cfg.Option[*Config] {
 }
 
 // WithErrorBufferSize defines the capacity of the error channel that the Runtime exposes in
-// its Runtime.Err method.
+// its Runtime.Err method, which is also the capacity of the results channel exposed in its Runtime.Results method.
 func WithErrorBufferSize(size int) cfg.Option[*Config] {
 	if size < 0 {
 		size = defaultBufferSize
@@ -107,6 +112,75 @@ func WithErrorBufferSize(size int) cfg.Option[*Config] {
 	})
 }
 
+// WithDrainTimeout configures the Runtime to wait up to the input duration for in-flight executions to complete when
+// its Run method returns (e.g. when its context.Context is cancelled). Tasks that do not finish in time are logged.
+//
+// Only selector.Selector implementations that detach from their executions (like the non-blocking selector.Selector)
+// have in-flight executions to wait for.
+//
+// This call returns a cfg.NoOp cfg.Option if the input duration is zero or below, which disables draining.
+func WithDrainTimeout(dur time.Duration) cfg.Option[*Config] {
+	if dur <= 0 {
+		return cfg.NoOp[*Config]{}
+	}
+
+	return cfg.Register(func(config *Config) *Config {
+		config.drainTimeout = dur
+
+		return config
+	})
+}
+
+// WithStartDelay configures the Runtime to wait for the input duration when its Run method is called, before selecting
+// any tasks. This staggers the start of multiple Runtime instances booting at the same time, avoiding a thundering
+// herd of executions.
+//
+// The delay is interrupted if Run's context.Context is done, in which case Run returns without executing any tasks.
+//
+// This call returns a cfg.NoOp cfg.Option if the input duration is zero or below, which disables the delay.
+func WithStartDelay(dur time.Duration) cfg.Option[*Config] {
+	if dur <= 0 {
+		return cfg.NoOp[*Config]{}
+	}
+
+	return cfg.Register(func(config *Config) *Config {
+		config.startDelay = dur
+
+		return config
+	})
+}
+
+// WithStopOnError configures the Runtime to return from its Run method on the first error raised by its
+// selector.Selector, instead of continuing to select tasks. The error is still sent to the Runtime's errors channel.
+//
+// By default, the Runtime keeps running after an error.
+func WithStopOnError() cfg.Option[*Config] {
+	return cfg.Register(func(config *Config) *Config {
+		config.stopOnError = true
+
+		return config
+	})
+}
+
+// WithMaxRuns configures the Runtime to stop after the input number of successful selections, returning from its Run
+// method as if its context.Context was cancelled. This is useful for smoke tests and batch backfills, where the Runtime
+// should not run forever.
+//
+// The number of successful selections is exposed via the Runtime's Runs method.
+//
+// This call returns a cfg.NoOp cfg.Option if the input number is zero or below, which leaves the runs unlimited.
+func WithMaxRuns(n int) cfg.Option[*Config] {
+	if n <= 0 {
+		return cfg.NoOp[*Config]{}
+	}
+
+	return cfg.Register(func(config *Config) *Config {
+		config.maxRuns = n
+
+		return config
+	})
+}
+
 // WithMetrics decorates the Runtime with the input metrics registry.
 func WithMetrics(m Metrics) cfg.Option[*Config] {
 	if m == nil {
@@ -5,17 +5,22 @@ import (
 	"errors"
 	"io"
 	"log/slog"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/zalgonoise/cfg"
 	"github.com/zalgonoise/x/is"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"go.opentelemetry.io/otel/trace"
 	"go.opentelemetry.io/otel/trace/noop"
 
 	"github.com/zalgonoise/micron/executor"
 	"github.com/zalgonoise/micron/log"
 	"github.com/zalgonoise/micron/metrics"
+	"github.com/zalgonoise/micron/schedule"
 	"github.com/zalgonoise/micron/selector"
 )
 
@@ -54,6 +59,18 @@ func TestConfig(t *testing.T) {
 				WithErrorBufferSize(-10),
 			},
 		},
+		{
+			name: "WithDrainTimeout/Zero",
+			opts: []cfg.Option[*Config]{
+				WithDrainTimeout(0),
+			},
+		},
+		{
+			name: "WithDrainTimeout/OneSecond",
+			opts: []cfg.Option[*Config]{
+				WithDrainTimeout(time.Second),
+			},
+		},
 		{
 			name: "WithMetrics/NilMetrics",
 			opts: []cfg.Option[*Config]{
@@ -115,6 +132,9 @@ func TestRuntimeWithLogs(t *testing.T) {
 		sel: selector.NoOp(),
 		err: make(chan error),
 
+		running: &atomic.Bool{},
+		runs:    &atomic.Int64{},
+
 		logger:  slog.New(log.NoOp()),
 		metrics: metrics.NoOp(),
 		tracer:  noop.NewTracerProvider().Tracer("test"),
@@ -157,6 +177,8 @@ func TestRuntimeWithLogs(t *testing.T) {
 			r: runtime{
 				sel:     r.sel,
 				err:     r.err,
+				running: r.running,
+				runs:    r.runs,
 				logger:  log.New(slog.NewTextHandler(io.Discard, nil)),
 				metrics: metrics.NoOp(),
 				tracer:  noop.NewTracerProvider().Tracer("test"),
@@ -194,6 +216,9 @@ func TestRuntimeWithMetrics(t *testing.T) {
 		sel: selector.NoOp(),
 		err: make(chan error),
 
+		running: &atomic.Bool{},
+		runs:    &atomic.Int64{},
+
 		logger:  slog.New(log.NoOp()),
 		metrics: metrics.NoOp(),
 		tracer:  noop.NewTracerProvider().Tracer("test"),
@@ -237,6 +262,9 @@ func TestRuntimeWithMetrics(t *testing.T) {
 				sel: selector.NoOp(),
 				err: make(chan error),
 
+				running: &atomic.Bool{},
+				runs:    &atomic.Int64{},
+
 				logger:  slog.New(log.NoOp()),
 				metrics: metrics.NoOp(),
 				tracer:  noop.NewTracerProvider().Tracer("test"),
@@ -274,6 +302,9 @@ func TestRuntimeWithTrace(t *testing.T) {
 		sel: selector.NoOp(),
 		err: make(chan error),
 
+		running: &atomic.Bool{},
+		runs:    &atomic.Int64{},
+
 		logger:  slog.New(log.NoOp()),
 		metrics: metrics.NoOp(),
 		tracer:  noop.NewTracerProvider().Tracer("test"),
@@ -312,6 +343,9 @@ func TestRuntimeWithTrace(t *testing.T) {
 				sel: selector.NoOp(),
 				err: make(chan error),
 
+				running: &atomic.Bool{},
+				runs:    &atomic.Int64{},
+
 				logger:  slog.New(log.NoOp()),
 				metrics: metrics.NoOp(),
 				tracer:  noop.NewTracerProvider().Tracer("test"),
@@ -348,6 +382,8 @@ func TestNoOp(t *testing.T) {
 
 	noOp.Run(context.Background())
 	is.Empty(t, noOp.Err())
+	is.Empty(t, noOp.RunOnce(context.Background()))
+	is.False(t, noOp.IsRunning())
 }
 
 func TestNew_NilSelector(t *testing.T) {
@@ -446,3 +482,561 @@ func TestNewWithJob(t *testing.T) {
 		})
 	}
 }
+
+// onceScheduler triggers immediately on its first Next call, and has no further occurrences.
+type onceScheduler struct {
+	fired *atomic.Bool
+}
+
+func (s onceScheduler) Next(_ context.Context, t time.Time) time.Time {
+	if s.fired.Swap(true) {
+		return time.Time{}
+	}
+
+	return t
+}
+
+func (s onceScheduler) Prev(context.Context, time.Time) time.Time { return time.Time{} }
+
+func (s onceScheduler) Until(ctx context.Context, t time.Time) time.Duration {
+	if s.Next(ctx, t).IsZero() {
+		return schedule.Never
+	}
+
+	return 0
+}
+
+func TestRuntime_Drain(t *testing.T) {
+	const runDuration = 300 * time.Millisecond
+
+	for _, testcase := range []struct {
+		name         string
+		drainTimeout time.Duration
+		completed    bool
+	}{
+		{
+			name:         "WaitsForInFlightTasks",
+			drainTimeout: time.Second,
+			completed:    true,
+		},
+		{
+			name:         "TimesOut",
+			drainTimeout: 10 * time.Millisecond,
+		},
+		{
+			name: "Disabled",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var completed atomic.Bool
+
+			exec, err := executor.New("drain",
+				executor.WithScheduler(onceScheduler{fired: &atomic.Bool{}}),
+				executor.WithRunners(executor.Runnable(func(context.Context) error {
+					time.Sleep(runDuration)
+					completed.Store(true)
+
+					return nil
+				})),
+			)
+			is.Empty(t, err)
+
+			sel, err := selector.New(
+				selector.WithExecutors(exec),
+				selector.WithTimeout(100*time.Millisecond),
+			)
+			is.Empty(t, err)
+
+			buf := &strings.Builder{}
+
+			r, err := New(
+				WithSelector(sel),
+				WithDrainTimeout(testcase.drainTimeout),
+				WithLogHandler(slog.NewTextHandler(buf, nil)),
+			)
+			is.Empty(t, err)
+
+			// the selector detaches from the running task, and Run returns once the task has no further executions
+			r.Run(context.Background())
+
+			is.Equal(t, testcase.completed, completed.Load())
+			is.Equal(t, testcase.drainTimeout > 0 && !testcase.completed,
+				strings.Contains(buf.String(), "did not finish within the drain timeout"))
+		})
+	}
+}
+
+func TestRuntime_Schedule(t *testing.T) {
+	runner := executor.Runnable(func(context.Context) error { return nil })
+
+	r, err := New(
+		WithJob("secondly", "* * * * * *", runner),
+		WithJob("hourly", "0 * * * *", runner),
+	)
+	is.Empty(t, err)
+
+	jobs := r.Schedule(context.Background())
+
+	is.Equal(t, 2, len(jobs))
+	is.Equal(t, "secondly", jobs[0].ID)
+	is.Equal(t, "hourly", jobs[1].ID)
+	is.False(t, jobs[0].Next.After(jobs[1].Next))
+	is.Equal(t, 0, len(NoOp().Schedule(context.Background())))
+}
+
+func TestRuntime_IntervalSchedule(t *testing.T) {
+	const (
+		intervals   = 3
+		runDuration = intervals*time.Second + 500*time.Millisecond
+	)
+
+	var runs atomic.Int32
+
+	exec, err := executor.New("interval",
+		executor.WithSchedule("@every 1s"),
+		executor.WithRunners(executor.Runnable(func(context.Context) error {
+			runs.Add(1)
+
+			return nil
+		})),
+	)
+	is.Empty(t, err)
+
+	// the selector detaches from the waiting executor and polls it again, several times within each interval
+	sel, err := selector.New(
+		selector.WithExecutors(exec),
+		selector.WithTimeout(100*time.Millisecond),
+	)
+	is.Empty(t, err)
+
+	r, err := New(WithSelector(sel))
+	is.Empty(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), runDuration)
+	defer cancel()
+
+	r.Run(ctx)
+
+	// the task runs once per interval, regardless of how many times the selector polls it
+	is.Equal(t, int32(intervals), runs.Load())
+}
+
+func TestRuntime_RebootScheduleQueriedBeforeRun(t *testing.T) {
+	var runs atomic.Int32
+
+	r, err := New(WithJob("reboot", "@reboot", executor.Runnable(func(context.Context) error {
+		runs.Add(1)
+
+		return nil
+	})))
+	is.Empty(t, err)
+
+	// querying the schedule does not spend the single occurrence, even once it is due
+	jobs := r.Schedule(context.Background())
+	is.Equal(t, 1, len(jobs))
+	is.True(t, !jobs[0].Next.IsZero())
+
+	time.Sleep(1500 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	r.Run(ctx)
+
+	is.Equal(t, int32(1), runs.Load())
+	is.Empty(t, ctx.Err())
+}
+
+func TestRuntime_RunOnce(t *testing.T) {
+	errFailed := errors.New("failed")
+
+	for _, testcase := range []struct {
+		name string
+		err  error
+	}{
+		{
+			name: "Success",
+		},
+		{
+			name: "RunnerError",
+			err:  errFailed,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var calls atomic.Int32
+
+			exec, err := executor.New("once",
+				executor.WithSchedule("* * * * * *"),
+				executor.WithRunners(executor.Runnable(func(context.Context) error {
+					calls.Add(1)
+
+					return testcase.err
+				})),
+			)
+			is.Empty(t, err)
+
+			// a blocking selector waits for the execution, which may take longer than the default selector timeout
+			sel, err := selector.New(selector.WithExecutors(exec), selector.WithBlock())
+			is.Empty(t, err)
+
+			r, err := New(WithSelector(sel))
+			is.Empty(t, err)
+
+			err = r.RunOnce(context.Background())
+
+			is.True(t, errors.Is(err, testcase.err))
+			is.Equal(t, int32(1), calls.Load())
+			// errors are returned directly, and not channeled
+			is.Equal(t, 0, len(r.Err()))
+		})
+	}
+
+	t.Run("Exhausted", func(t *testing.T) {
+		exec, err := executor.New("exhausted",
+			executor.WithScheduler(onceScheduler{fired: &atomic.Bool{}}),
+			executor.WithRunners(executor.Runnable(func(context.Context) error { return nil })),
+		)
+		is.Empty(t, err)
+
+		sel, err := selector.New(selector.WithExecutors(exec))
+		is.Empty(t, err)
+
+		r, err := New(WithSelector(sel))
+		is.Empty(t, err)
+
+		is.Empty(t, r.RunOnce(context.Background()))
+		is.True(t, errors.Is(r.RunOnce(context.Background()), selector.ErrExhaustedExecutorsList))
+	})
+}
+
+func TestRuntime_IsRunning(t *testing.T) {
+	r, err := New(
+		WithJob("secondly", "* * * * * *", executor.Runnable(func(context.Context) error { return nil })),
+	)
+	is.Empty(t, err)
+
+	// the decorated Runtime shares the running state with the original one
+	logged := AddLogs(r, slog.NewTextHandler(io.Discard, nil))
+
+	is.False(t, r.IsRunning())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		r.Run(ctx)
+		close(done)
+	}()
+
+	deadline := time.Now().Add(time.Second)
+	for !r.IsRunning() && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+
+	is.True(t, r.IsRunning())
+	is.True(t, logged.IsRunning())
+
+	cancel()
+	<-done
+
+	is.False(t, r.IsRunning())
+}
+
+type upRecorder struct {
+	states []bool
+}
+
+func (m *upRecorder) IsUp(up bool) { m.states = append(m.states, up) }
+
+func TestDecorators_FromNew(t *testing.T) {
+	r, err := New(
+		WithJob("secondly", "* * * * * *", executor.Runnable(func(context.Context) error { return nil })),
+	)
+	is.Empty(t, err)
+
+	buf := &strings.Builder{}
+	handler := slog.NewTextHandler(buf, nil)
+	m := &upRecorder{}
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+	r = AddTraces(AddMetrics(AddLogs(r, handler), m), tracer)
+
+	decorated, ok := r.(runtime)
+	is.True(t, ok)
+	is.Equal(t, slog.Handler(handler), decorated.logger.Handler())
+	is.Equal(t, Metrics(m), decorated.metrics)
+	is.Equal(t, tracer, decorated.tracer)
+
+	// a cancelled context makes Run return right away, while still going through its logs, metrics and traces
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	r.Run(ctx)
+
+	is.True(t, strings.Contains(buf.String(), "starting cron"))
+	is.True(t, strings.Contains(buf.String(), "closing cron"))
+	is.Equal(t, 2, len(m.states))
+	is.True(t, m.states[0])
+	is.False(t, m.states[1])
+	is.Equal(t, 1, len(recorder.Ended()))
+	is.Equal(t, "Runtime.Run", recorder.Ended()[0].Name())
+}
+
+type countingSelector struct {
+	selector.Selector
+
+	calls *atomic.Int32
+}
+
+func (s countingSelector) Next(ctx context.Context) error {
+	s.calls.Add(1)
+	<-ctx.Done()
+
+	return nil
+}
+
+func TestRuntime_StartDelay(t *testing.T) {
+	const delay = 100 * time.Millisecond
+
+	for _, testcase := range []struct {
+		name    string
+		delay   time.Duration
+		timeout time.Duration
+		calls   int32
+	}{
+		{
+			name:    "SelectsAfterDelay",
+			delay:   delay,
+			timeout: 2 * delay,
+			calls:   1,
+		},
+		{
+			name:    "CancelledDuringDelay",
+			delay:   delay,
+			timeout: delay / 4,
+		},
+		{
+			name:    "NoDelay",
+			timeout: delay / 4,
+			calls:   1,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			calls := &atomic.Int32{}
+
+			r, err := New(
+				WithSelector(countingSelector{Selector: selector.NoOp(), calls: calls}),
+				WithStartDelay(testcase.delay),
+			)
+			is.Empty(t, err)
+
+			ctx, cancel := context.WithTimeout(context.Background(), testcase.timeout)
+			defer cancel()
+
+			r.Run(ctx)
+
+			is.Equal(t, testcase.calls, calls.Load())
+		})
+	}
+}
+
+type instantSelector struct {
+	selector.Selector
+}
+
+func (instantSelector) Next(context.Context) error {
+	return nil
+}
+
+func TestRuntime_MaxRuns(t *testing.T) {
+	for _, testcase := range []struct {
+		name    string
+		maxRuns int
+		wants   int
+	}{
+		{
+			name:    "Bounded",
+			maxRuns: 3,
+			wants:   3,
+		},
+		{
+			name:    "Unlimited",
+			maxRuns: 0,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			r, err := New(
+				WithSelector(instantSelector{Selector: selector.NoOp()}),
+				WithMaxRuns(testcase.maxRuns),
+			)
+			is.Empty(t, err)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			r.Run(ctx)
+
+			if testcase.wants == 0 {
+				// runs until the context is done
+				is.True(t, errors.Is(ctx.Err(), context.DeadlineExceeded))
+				is.True(t, r.Runs() > testcase.maxRuns)
+
+				return
+			}
+
+			is.Empty(t, ctx.Err())
+			is.Equal(t, testcase.wants, r.Runs())
+			is.False(t, r.IsRunning())
+		})
+	}
+}
+
+type failingSelector struct {
+	selector.Selector
+
+	err   error
+	calls *atomic.Int32
+}
+
+// Next fails on its first call, blocking on the following calls until the input context.Context is done.
+func (s failingSelector) Next(ctx context.Context) error {
+	if s.calls.Add(1) == 1 {
+		return s.err
+	}
+
+	<-ctx.Done()
+
+	return nil
+}
+
+func TestRuntime_StopOnError(t *testing.T) {
+	testErr := errors.New("test error")
+
+	for _, testcase := range []struct {
+		name  string
+		opts  []cfg.Option[*Config]
+		stops bool
+	}{
+		{
+			name:  "StopOnError",
+			opts:  []cfg.Option[*Config]{WithStopOnError()},
+			stops: true,
+		},
+		{
+			name: "Default",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			calls := &atomic.Int32{}
+
+			r, err := New(append(testcase.opts,
+				WithSelector(failingSelector{Selector: selector.NoOp(), err: testErr, calls: calls}),
+			)...)
+			is.Empty(t, err)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+
+			r.Run(ctx)
+
+			// the error is channeled in both cases
+			is.True(t, errors.Is(<-r.Err(), testErr))
+
+			if testcase.stops {
+				is.Empty(t, ctx.Err())
+				is.Equal(t, int32(1), calls.Load())
+
+				return
+			}
+
+			is.True(t, errors.Is(ctx.Err(), context.DeadlineExceeded))
+			is.True(t, calls.Load() > 1)
+		})
+	}
+}
+
+type runNowSelector struct {
+	selector.Selector
+
+	exec *executor.Executable
+}
+
+func (s runNowSelector) Next(ctx context.Context) error {
+	return s.exec.RunNow(ctx)
+}
+
+func TestRuntime_Results(t *testing.T) {
+	testErr := errors.New("test error")
+
+	var calls atomic.Int32
+
+	exec, err := executor.New("results",
+		executor.WithSchedule("0 0 1 1 *"),
+		executor.WithRunners(executor.Runnable(func(context.Context) error {
+			if calls.Add(1)%2 == 0 {
+				return testErr
+			}
+
+			return nil
+		})),
+	)
+	is.Empty(t, err)
+
+	t.Run("Run", func(t *testing.T) {
+		calls.Store(0)
+
+		r, err := New(
+			WithSelector(runNowSelector{Selector: selector.NoOp(), exec: exec.(*executor.Executable)}),
+			WithMaxRuns(200),
+		)
+		is.Empty(t, err)
+
+		stop := make(chan struct{})
+
+		// consuming the errors, so that the Runtime is not blocked on them
+		go func() {
+			for {
+				select {
+				case <-stop:
+					return
+				case <-r.Err():
+				}
+			}
+		}()
+
+		r.Run(context.Background())
+		close(stop)
+
+		// results are dropped once the channel is full, instead of blocking the Runtime
+		// only successful selections count as runs, with every other call failing
+		is.Equal(t, 200, r.Runs())
+		is.Equal(t, 399, int(calls.Load()))
+		is.Equal(t, minBufferSize, len(r.Results()))
+
+		for i := 0; i < minBufferSize; i++ {
+			result := <-r.Results()
+
+			is.Equal(t, "results", result.ID)
+			is.Equal(t, i%2 == 1, errors.Is(result.Err, testErr))
+		}
+	})
+
+	t.Run("RunOnce", func(t *testing.T) {
+		calls.Store(0)
+
+		r, err := New(WithSelector(runNowSelector{Selector: selector.NoOp(), exec: exec.(*executor.Executable)}))
+		is.Empty(t, err)
+
+		is.Empty(t, r.RunOnce(context.Background()))
+		is.Equal(t, 1, len(r.Results()))
+
+		result := <-r.Results()
+		is.Equal(t, "results", result.ID)
+		is.Empty(t, result.Err)
+	})
+
+	t.Run("NoOp", func(t *testing.T) {
+		is.True(t, NoOp().Results() == nil)
+	})
+}
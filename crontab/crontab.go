@@ -0,0 +1,260 @@
+// Package crontab loads crontab files, creating an executor.Executor for each of their entries.
+package crontab
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/zalgonoise/cfg"
+	"github.com/zalgonoise/x/errs"
+
+	"github.com/zalgonoise/micron/executor"
+)
+
+const (
+	errDomain = errs.Domain("micron/crontab")
+
+	ErrEmpty   = errs.Kind("empty")
+	ErrInvalid = errs.Kind("invalid")
+
+	ErrFactory    = errs.Entity("runner factory")
+	ErrLine       = errs.Entity("crontab line")
+	ErrCommand    = errs.Entity("command")
+	ErrAssignment = errs.Entity("environment variable assignment")
+	ErrLocation   = errs.Entity("location")
+)
+
+var (
+	ErrEmptyFactory      = errs.WithDomain(errDomain, ErrEmpty, ErrFactory)
+	ErrInvalidLine       = errs.WithDomain(errDomain, ErrInvalid, ErrLine)
+	ErrEmptyCommand      = errs.WithDomain(errDomain, ErrEmpty, ErrCommand)
+	ErrInvalidAssignment = errs.WithDomain(errDomain, ErrInvalid, ErrAssignment)
+	ErrInvalidLocation   = errs.WithDomain(errDomain, ErrInvalid, ErrLocation)
+)
+
+const (
+	commentPrefix  = "#"
+	overridePrefix = "@"
+	everyOverride  = "@every"
+	assignment     = "="
+	cronTZ         = "CRON_TZ"
+	tz             = "TZ"
+	shell          = "SHELL"
+
+	scheduleFields = 5
+	everyFields    = 2
+	overrideFields = 1
+
+	minAlloc = 16
+)
+
+// Factory creates the executor.Runner for a crontab entry, from its command and the environment variables assigned
+// before it.
+type Factory func(command string, env map[string]string) executor.Runner
+
+// CommandFactory returns a Factory that runs each crontab entry's command as an executor.Command, with `/bin/sh -c`
+// (or with the shell set in the crontab's `SHELL` variable), like cron does.
+//
+// The environment variables assigned in the crontab are added to the command's environment, and the input
+// cfg.Option(s) configure every command, such as its working directory with executor.WithCommandDir.
+func CommandFactory(options ...cfg.Option[executor.CommandConfig]) Factory {
+	return func(command string, env map[string]string) executor.Runner {
+		runner := executor.ShellRunner(command)
+
+		if sh := env[shell]; sh != "" {
+			runner = executor.CommandRunner(sh, "-c", command)
+		}
+
+		return runner.With(append(slices.Clone(options), executor.WithCommandEnv(env))...)
+	}
+}
+
+// Parse reads a crontab from the input io.Reader, and returns an executor.Executor for each of its entries, also
+// returning an error if raised.
+//
+// Each entry is a line with a schedule followed by a command, like in a classic crontab: either five cron fields
+// (minutes, hours, days of the month, months and days of the week) or an override like `@daily` or `@every 5m`. The
+// command is passed to the input Factory, which returns the executor.Runner for that entry.
+//
+// Lines like `KEY=VALUE` assign environment variables, which apply to the entries that follow them. The `CRON_TZ` and
+// `TZ` variables set the time.Location of the following entries' schedules (an empty value restores the default),
+// while any other variables are passed to the Factory, along with the entry's command.
+//
+// Blank lines and comments (lines starting with `#`) are skipped. Each executor.Executor is identified by its line
+// number, as `crontab:<line>`.
+//
+// Errors raised when parsing an entry or an assignment are wrapped in ErrInvalidLine, with its line number.
+func Parse(r io.Reader, factory Factory) ([]executor.Executor, error) {
+	if factory == nil {
+		return nil, ErrEmptyFactory
+	}
+
+	var (
+		loc     *time.Location
+		env     = make(map[string]string)
+		execs   = make([]executor.Executor, 0, minAlloc)
+		scanner = bufio.NewScanner(r)
+	)
+
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, commentPrefix) {
+			continue
+		}
+
+		key, value, ok, err := parseAssignment(line)
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %w", ErrInvalidLine, n, err)
+		}
+
+		if ok {
+			if loc, err = assign(env, loc, key, value); err != nil {
+				return nil, fmt.Errorf("%w: line %d: %w", ErrInvalidLine, n, err)
+			}
+
+			continue
+		}
+
+		exec, err := parseLine(n, line, loc, maps.Clone(env), factory)
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %w", ErrInvalidLine, n, err)
+		}
+
+		execs = append(execs, exec)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return execs, nil
+}
+
+func parseLine(
+	n int, line string, loc *time.Location, env map[string]string, factory Factory,
+) (executor.Executor, error) {
+	numFields := scheduleFields
+
+	switch {
+	case strings.HasPrefix(line, everyOverride):
+		numFields = everyFields
+	case strings.HasPrefix(line, overridePrefix):
+		numFields = overrideFields
+	}
+
+	cron, command := splitFields(line, numFields)
+	if command == "" {
+		return nil, ErrEmptyCommand
+	}
+
+	return executor.New(fmt.Sprintf("crontab:%d", n),
+		executor.WithSchedule(cron),
+		executor.WithLocation(loc),
+		executor.WithRunners(factory(command, env)),
+	)
+}
+
+// parseAssignment checks whether the input line is an environment variable assignment, returning its key and value if
+// so. An error is returned if the line is an assignment with an invalid variable name.
+//
+// A line is an assignment if the text before its first `=` sign does not contain any whitespace, like
+// `SHELL=/bin/bash` or `MAILTO = ""`. Schedule entries either do not contain an `=` sign or contain whitespace
+// before it, as in `0 3 * * * FOO=bar cmd`.
+func parseAssignment(line string) (key, value string, ok bool, err error) {
+	idx := strings.Index(line, assignment)
+	if idx < 0 {
+		return "", "", false, nil
+	}
+
+	key = strings.TrimSpace(line[:idx])
+	if strings.ContainsFunc(key, unicode.IsSpace) {
+		return "", "", false, nil
+	}
+
+	if !isValidName(key) {
+		return "", "", false, fmt.Errorf("%w: invalid variable name %q", ErrInvalidAssignment, key)
+	}
+
+	return key, unquote(strings.TrimSpace(line[idx+len(assignment):])), true, nil
+}
+
+func assign(env map[string]string, loc *time.Location, key, value string) (*time.Location, error) {
+	if key != cronTZ && key != tz {
+		env[key] = value
+
+		return loc, nil
+	}
+
+	if value == "" {
+		return nil, nil
+	}
+
+	l, err := time.LoadLocation(value)
+	if err != nil {
+		return loc, fmt.Errorf("%w: %q: %w", ErrInvalidLocation, value, err)
+	}
+
+	return l, nil
+}
+
+func isValidName(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	for i, r := range name {
+		switch {
+		case r == '_', r >= 'A' && r <= 'Z', r >= 'a' && r <= 'z':
+		case r >= '0' && r <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+func unquote(value string) string {
+	const minQuoted = 2
+
+	if len(value) < minQuoted {
+		return value
+	}
+
+	if (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1]
+	}
+
+	return value
+}
+
+// splitFields splits the input line into its first n whitespace-separated fields, joined by a single space, and the
+// remainder of the line, which is kept as-is.
+func splitFields(line string, n int) (fields, rest string) {
+	rest = line
+	values := make([]string, 0, n)
+
+	for len(values) < n {
+		rest = strings.TrimLeftFunc(rest, unicode.IsSpace)
+		if rest == "" {
+			break
+		}
+
+		end := strings.IndexFunc(rest, unicode.IsSpace)
+		if end < 0 {
+			end = len(rest)
+		}
+
+		values = append(values, rest[:end])
+		rest = rest[end:]
+	}
+
+	return strings.Join(values, " "), strings.TrimSpace(rest)
+}
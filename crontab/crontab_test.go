@@ -0,0 +1,246 @@
+package crontab
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zalgonoise/x/is"
+
+	"github.com/zalgonoise/micron/executor"
+	"github.com/zalgonoise/micron/schedule/cronlex"
+)
+
+func TestParse(t *testing.T) {
+	for _, testcase := range []struct {
+		name     string
+		crontab  string
+		nilFn    bool
+		ids      []string
+		commands []string
+		err      error
+		line     string
+	}{
+		{
+			name: "Success/WithCommentsAndBlankLines",
+			crontab: `# backups
+0 3 * * *	/usr/local/bin/backup --full   --verbose
+
+*/15 * * * * echo "quarter  hour"
+  # indented comment
+@hourly /usr/bin/rotate
+@every 5m /usr/bin/poll
+`,
+			ids: []string{"crontab:2", "crontab:4", "crontab:6", "crontab:7"},
+			commands: []string{
+				"/usr/local/bin/backup --full   --verbose",
+				`echo "quarter  hour"`,
+				"/usr/bin/rotate",
+				"/usr/bin/poll",
+			},
+		},
+		{
+			name:    "Success/Empty",
+			crontab: "\n# nothing to see here\n",
+		},
+		{
+			name:    "Fail/NilFactory",
+			crontab: "* * * * * echo",
+			nilFn:   true,
+			err:     ErrEmptyFactory,
+		},
+		{
+			name:    "Fail/MissingCommand",
+			crontab: "# comment\n\n0 3 * * *\n",
+			err:     ErrEmptyCommand,
+			line:    "line 3",
+		},
+		{
+			name:    "Fail/InvalidOverride",
+			crontab: "@yearlyish echo\n",
+			err:     cronlex.ErrInvalidFrequency,
+			line:    "line 1",
+		},
+		{
+			name:    "Fail/InvalidSchedule",
+			crontab: "* * * * * echo\n0 3 * * % echo\n",
+			err:     cronlex.ErrInvalidCharacter,
+			line:    "line 2",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			commands := make([]string, 0, len(testcase.commands))
+
+			var factory Factory = func(command string, _ map[string]string) executor.Runner {
+				commands = append(commands, command)
+
+				return executor.Runnable(func(context.Context) error { return nil })
+			}
+
+			if testcase.nilFn {
+				factory = nil
+			}
+
+			execs, err := Parse(strings.NewReader(testcase.crontab), factory)
+			if testcase.err != nil {
+				is.True(t, errors.Is(err, testcase.err))
+				is.True(t, strings.Contains(err.Error(), testcase.line))
+
+				if testcase.line != "" {
+					is.True(t, errors.Is(err, ErrInvalidLine))
+				}
+
+				return
+			}
+
+			is.Empty(t, err)
+			is.Equal(t, len(testcase.ids), len(execs))
+			is.Equal(t, len(testcase.commands), len(commands))
+
+			for i := range execs {
+				is.Equal(t, testcase.ids[i], execs[i].ID())
+				is.Equal(t, testcase.commands[i], commands[i])
+				is.False(t, execs[i].Next(context.Background()).IsZero())
+			}
+		})
+	}
+}
+
+func TestParse_Environment(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	is.Empty(t, err)
+
+	newYork, err := time.LoadLocation("America/New_York")
+	is.Empty(t, err)
+
+	for _, testcase := range []struct {
+		name    string
+		crontab string
+		envs    []map[string]string
+		locs    []*time.Location
+		err     error
+		line    string
+	}{
+		{
+			name: "Success/ScopedVariables",
+			crontab: `SHELL=/bin/bash
+MAILTO = ""
+0 3 * * * backup
+CRON_TZ=Asia/Tokyo
+PATH='/usr/bin:/bin'
+0 9 * * * report
+TZ=America/New_York
+SHELL=/bin/sh
+0 9 * * * report
+CRON_TZ=
+0 9 * * * FOO=bar report
+`,
+			envs: []map[string]string{
+				{"SHELL": "/bin/bash", "MAILTO": ""},
+				{"SHELL": "/bin/bash", "MAILTO": "", "PATH": "/usr/bin:/bin"},
+				{"SHELL": "/bin/sh", "MAILTO": "", "PATH": "/usr/bin:/bin"},
+				{"SHELL": "/bin/sh", "MAILTO": "", "PATH": "/usr/bin:/bin"},
+			},
+			locs: []*time.Location{time.Local, tokyo, newYork, time.Local},
+		},
+		{
+			name:    "Fail/InvalidName",
+			crontab: "SHELL=/bin/bash\n1FOO=bar\n",
+			err:     ErrInvalidAssignment,
+			line:    "line 2",
+		},
+		{
+			name:    "Fail/EmptyName",
+			crontab: "=bar\n",
+			err:     ErrInvalidAssignment,
+			line:    "line 1",
+		},
+		{
+			name:    "Fail/InvalidLocation",
+			crontab: "0 3 * * * backup\nCRON_TZ=Mars/Olympus_Mons\n",
+			err:     ErrInvalidLocation,
+			line:    "line 2",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			envs := make([]map[string]string, 0, len(testcase.envs))
+
+			execs, err := Parse(strings.NewReader(testcase.crontab), func(_ string, env map[string]string) executor.Runner {
+				envs = append(envs, env)
+
+				return executor.Runnable(func(context.Context) error { return nil })
+			})
+			if testcase.err != nil {
+				is.True(t, errors.Is(err, testcase.err))
+				is.True(t, errors.Is(err, ErrInvalidLine))
+				is.True(t, strings.Contains(err.Error(), testcase.line))
+
+				return
+			}
+
+			is.Empty(t, err)
+			is.Equal(t, len(testcase.envs), len(envs))
+			is.Equal(t, len(testcase.locs), len(execs))
+
+			for i := range envs {
+				is.True(t, maps.Equal(testcase.envs[i], envs[i]))
+			}
+
+			for i := range execs {
+				is.Equal(t, testcase.locs[i].String(), execs[i].Next(context.Background()).Location().String())
+			}
+		})
+	}
+}
+
+func TestCommandFactory(t *testing.T) {
+	for _, testcase := range []struct {
+		name    string
+		command string
+		env     map[string]string
+		output  string
+		fail    bool
+	}{
+		{
+			name:    "Success/WithEnvironment",
+			command: `echo "$GREETING, $NAME"`,
+			env:     map[string]string{"GREETING": "hello", "NAME": "crontab"},
+			output:  "hello, crontab\n",
+		},
+		{
+			name:    "Success/WithShell",
+			command: "echo from a custom shell",
+			env:     map[string]string{"SHELL": "/bin/sh"},
+			output:  "from a custom shell\n",
+		},
+		{
+			name:    "Fail/WithShell",
+			command: "echo unreachable",
+			env:     map[string]string{"SHELL": "/bin/false"},
+			fail:    true,
+		},
+		{
+			name:    "Fail/NonZeroExit",
+			command: "exit 3",
+			fail:    true,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			buf := &strings.Builder{}
+			factory := CommandFactory(executor.WithCommandOutput(buf, nil))
+
+			err := factory(testcase.command, testcase.env).Run(context.Background())
+			if testcase.fail {
+				is.True(t, errors.Is(err, executor.ErrFailedCommand))
+
+				return
+			}
+
+			is.Empty(t, err)
+			is.Equal(t, testcase.output, buf.String())
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/zalgonoise/cfg"
+)
+
+const (
+	defaultShell = "/bin/sh"
+
+	// commandWaitDelay bounds how long a cancelled process' output is waited for, after it is killed, as its own
+	// child processes (e.g. when running a shell) may keep its output open.
+	commandWaitDelay = 500 * time.Millisecond
+
+	// maxErrorOutput limits how much of a failed command's standard error is included in its error.
+	maxErrorOutput = 1024
+)
+
+// CommandConfig configures a Command's working directory, environment and output.
+type CommandConfig struct {
+	dir string
+	env map[string]string
+
+	stdout io.Writer
+	stderr io.Writer
+}
+
+// WithCommandDir sets the working directory of a Command, which is the current process' working directory by default.
+//
+// This call returns a cfg.NoOp cfg.Option if the input directory is empty.
+func WithCommandDir(dir string) cfg.Option[CommandConfig] {
+	if dir == "" {
+		return cfg.NoOp[CommandConfig]{}
+	}
+
+	return cfg.Register(func(config CommandConfig) CommandConfig {
+		config.dir = dir
+
+		return config
+	})
+}
+
+// WithCommandEnv adds the input environment variables to a Command, on top of the current process' environment.
+// Multiple calls add up, where the latest value set for a variable takes precedence.
+//
+// This call returns a cfg.NoOp cfg.Option if the input map is empty.
+func WithCommandEnv(env map[string]string) cfg.Option[CommandConfig] {
+	if len(env) == 0 {
+		return cfg.NoOp[CommandConfig]{}
+	}
+
+	env = maps.Clone(env)
+
+	return cfg.Register(func(config CommandConfig) CommandConfig {
+		merged := make(map[string]string, len(config.env)+len(env))
+
+		maps.Copy(merged, config.env)
+		maps.Copy(merged, env)
+
+		config.env = merged
+
+		return config
+	})
+}
+
+// WithCommandOutput sets the io.Writer(s) that a Command's standard output and standard error are written to, which
+// are discarded by default. Either io.Writer may be nil to keep discarding that output.
+//
+// This call returns a cfg.NoOp cfg.Option if both io.Writer are nil.
+func WithCommandOutput(stdout, stderr io.Writer) cfg.Option[CommandConfig] {
+	if stdout == nil && stderr == nil {
+		return cfg.NoOp[CommandConfig]{}
+	}
+
+	return cfg.Register(func(config CommandConfig) CommandConfig {
+		config.stdout = stdout
+		config.stderr = stderr
+
+		return config
+	})
+}
+
+// Command is a Runner that launches a process, like a crontab command.
+//
+// Each Run call starts a new process with the Run call's context.Context, which kills it if the context is cancelled
+// (e.g. on a runner timeout). A process exiting with a non-zero status results in an ErrFailedCommand error, which wraps
+// the *exec.ExitError and includes the beginning of the process' standard error.
+type Command struct {
+	name string
+	args []string
+
+	config CommandConfig
+}
+
+// CommandRunner creates a Command that runs the named program with the input arguments, without a shell. The name is
+// resolved with exec.LookPath if it does not contain a path separator.
+func CommandRunner(name string, args ...string) *Command {
+	return &Command{
+		name: name,
+		args: slices.Clone(args),
+	}
+}
+
+// ShellRunner creates a Command that runs the input command line with `/bin/sh -c`, like a crontab command.
+func ShellRunner(line string) *Command {
+	return CommandRunner(defaultShell, "-c", line)
+}
+
+// With returns a copy of the Command configured with the input cfg.Option(s), such as WithCommandDir, WithCommandEnv
+// and WithCommandOutput.
+func (c *Command) With(options ...cfg.Option[CommandConfig]) *Command {
+	return &Command{
+		name:   c.name,
+		args:   c.args,
+		config: cfg.Set(c.config, options...),
+	}
+}
+
+// Run launches the Command's process and waits for it to exit, returning an error if it fails to start or exits with
+// a non-zero status.
+func (c *Command) Run(ctx context.Context) error {
+	if c.name == "" {
+		return fmt.Errorf("%w: empty command name", ErrFailedCommand)
+	}
+
+	stderr := &limitedBuffer{limit: maxErrorOutput}
+
+	//nolint:gosec // running the configured command is the purpose of this Runner
+	cmd := exec.CommandContext(ctx, c.name, c.args...)
+	cmd.Dir = c.config.dir
+	cmd.WaitDelay = commandWaitDelay
+	cmd.Stdout = c.config.stdout
+	cmd.Stderr = stderr
+
+	if c.config.stderr != nil {
+		cmd.Stderr = io.MultiWriter(stderr, c.config.stderr)
+	}
+
+	if len(c.config.env) > 0 {
+		cmd.Env = os.Environ()
+
+		keys := make([]string, 0, len(c.config.env))
+		for key := range c.config.env {
+			keys = append(keys, key)
+		}
+
+		slices.Sort(keys)
+
+		for _, key := range keys {
+			cmd.Env = append(cmd.Env, key+"="+c.config.env[key])
+		}
+	}
+
+	if err := cmd.Run(); err != nil {
+		if output := strings.TrimSpace(stderr.String()); output != "" {
+			return fmt.Errorf("%w: %s: %w: %s", ErrFailedCommand, c.name, err, output)
+		}
+
+		return fmt.Errorf("%w: %s: %w", ErrFailedCommand, c.name, err)
+	}
+
+	return nil
+}
+
+// limitedBuffer is an io.Writer that keeps up to limit bytes of what is written to it, discarding the rest.
+type limitedBuffer struct {
+	limit int
+	buf   []byte
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if remaining := b.limit - len(b.buf); remaining > 0 {
+		b.buf = append(b.buf, p[:min(len(p), remaining)]...)
+	}
+
+	return len(p), nil
+}
+
+func (b *limitedBuffer) String() string {
+	return string(b.buf)
+}
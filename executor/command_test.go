@@ -0,0 +1,129 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zalgonoise/cfg"
+	"github.com/zalgonoise/x/is"
+)
+
+func TestCommand_Run(t *testing.T) {
+	dir := t.TempDir()
+
+	for _, testcase := range []struct {
+		name     string
+		runner   *Command
+		options  []cfg.Option[CommandConfig]
+		stdout   string
+		exitCode int
+		errMsg   string
+		err      error
+	}{
+		{
+			name:   "Success/Command",
+			runner: CommandRunner("echo", "hello", "world"),
+			stdout: "hello world\n",
+		},
+		{
+			name:   "Success/Shell",
+			runner: ShellRunner("echo hello | tr a-z A-Z"),
+			stdout: "HELLO\n",
+		},
+		{
+			name:    "Success/WithDir",
+			runner:  ShellRunner("pwd"),
+			options: []cfg.Option[CommandConfig]{WithCommandDir(dir)},
+			stdout:  dir + "\n",
+		},
+		{
+			name:   "Success/WithEnv",
+			runner: ShellRunner(`echo "$FOO $BAR"`),
+			options: []cfg.Option[CommandConfig]{
+				WithCommandEnv(map[string]string{"FOO": "foo", "BAR": "bar"}),
+				WithCommandEnv(map[string]string{"BAR": "baz"}),
+			},
+			stdout: "foo baz\n",
+		},
+		{
+			name:    "Success/NoOpOptions",
+			runner:  CommandRunner("echo", "ok"),
+			options: []cfg.Option[CommandConfig]{WithCommandDir(""), WithCommandEnv(nil)},
+			stdout:  "ok\n",
+		},
+		{
+			name:     "Fail/NonZeroExit",
+			runner:   ShellRunner("echo partial; echo something went wrong >&2; exit 3"),
+			stdout:   "partial\n",
+			exitCode: 3,
+			errMsg:   "something went wrong",
+			err:      ErrFailedCommand,
+		},
+		{
+			name:   "Fail/NotFound",
+			runner: CommandRunner("micron-command-that-does-not-exist"),
+			err:    exec.ErrNotFound,
+		},
+		{
+			name:   "Fail/EmptyName",
+			runner: CommandRunner(""),
+			err:    ErrFailedCommand,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			stdout := &strings.Builder{}
+			stderr := &strings.Builder{}
+
+			options := append(testcase.options, WithCommandOutput(stdout, stderr))
+
+			err := testcase.runner.With(options...).Run(context.Background())
+			is.Equal(t, testcase.stdout, stdout.String())
+
+			if testcase.err == nil {
+				is.Empty(t, err)
+
+				return
+			}
+
+			is.True(t, errors.Is(err, testcase.err))
+			is.True(t, errors.Is(err, ErrFailedCommand))
+
+			if testcase.exitCode != 0 {
+				var exitErr *exec.ExitError
+
+				is.True(t, errors.As(err, &exitErr))
+				is.Equal(t, testcase.exitCode, exitErr.ExitCode())
+			}
+
+			if testcase.errMsg != "" {
+				is.True(t, strings.Contains(err.Error(), testcase.errMsg))
+				is.True(t, strings.Contains(stderr.String(), testcase.errMsg))
+			}
+		})
+	}
+}
+
+func TestCommand_RunCancelled(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := ShellRunner("sleep 5").Run(ctx)
+
+	is.True(t, errors.Is(err, ErrFailedCommand))
+	is.True(t, time.Since(start) < 2*time.Second)
+}
+
+func TestCommand_WithIsolated(t *testing.T) {
+	base := ShellRunner(`echo "$FOO"`)
+	stdout := &strings.Builder{}
+
+	_ = base.With(WithCommandEnv(map[string]string{"FOO": "foo"}))
+
+	is.Empty(t, base.With(WithCommandOutput(stdout, nil)).Run(context.Background()))
+	is.Equal(t, "\n", stdout.String())
+}
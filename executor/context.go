@@ -0,0 +1,135 @@
+package executor
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"time"
+)
+
+type scheduledTimeKey struct{}
+
+// ScheduledTime returns the time that the current execution was scheduled for, as set by the Executor in the
+// context.Context passed to its runners, and a boolean reporting whether it is present.
+//
+// This is the logical scheduled instant from the Executor's schedule.Scheduler, not the wall-clock time when the Runner
+// started, which may be later due to jitter, the trigger buffer or any delays. This makes it suitable for idempotency
+// keys or windowed queries, consistent across retries of the same execution.
+func ScheduledTime(ctx context.Context) (time.Time, bool) {
+	t, ok := ctx.Value(scheduledTimeKey{}).(time.Time)
+
+	return t, ok
+}
+
+func withScheduledTime(ctx context.Context, t time.Time) context.Context {
+	return context.WithValue(ctx, scheduledTimeKey{}, t)
+}
+
+type runIDKey struct{}
+
+// RunID returns the unique identifier of the current Exec call, as set by the Executor in the context.Context passed to
+// its runners. All runners in the same Exec call share this ID, which is also present in the Executor's span
+// attributes and log records as `run_id`.
+//
+// An empty string is returned if the context.Context carries no run ID.
+func RunID(ctx context.Context) string {
+	id, _ := ctx.Value(runIDKey{}).(string)
+
+	return id
+}
+
+func withRunID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, runIDKey{}, id)
+}
+
+// newRunID generates a random (version 4) UUID string.
+//
+//nolint:gomnd // byte offsets and bit masks from the UUID layout in RFC 4122
+func newRunID() string {
+	var id [16]byte
+
+	// crypto/rand.Read does not fail on supported platforms
+	_, _ = rand.Read(id[:])
+
+	id[6] = (id[6] & 0x0f) | 0x40 // version 4
+	id[8] = (id[8] & 0x3f) | 0x80 // RFC 4122 variant
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", id[0:4], id[4:6], id[6:8], id[8:10], id[10:])
+}
+
+// ExecResult describes a completed execution of an Executor's runners, as reported to a result handler set with
+// WithResultHandler.
+type ExecResult struct {
+	// ID is the Executor's ID.
+	ID string
+	// RunID is the unique identifier of the execution, as returned by RunID.
+	RunID string
+	// Scheduled is the time that the execution was scheduled for, as returned by ScheduledTime.
+	Scheduled time.Time
+	// Start is the time that the Executor started calling its runners.
+	Start time.Time
+	// Duration is how long the Executor's runners took to complete, including any retries.
+	Duration time.Duration
+	// Err is the (joined) error raised by the Executor's runners, if any.
+	Err error
+	// DryRun is true if the runners were not called, as the Executor is in dry-run mode (see WithDryRun).
+	DryRun bool
+}
+
+type resultHandlerKey struct{}
+
+// WithResultHandler returns a copy of the input context.Context carrying the input function, which an Executor calls
+// with the ExecResult of each execution that it completes with that context.Context (in its Exec and RunNow calls).
+// Executions skipped or cancelled before calling the runners are not reported.
+//
+// The function is called synchronously, in the Executor's goroutine, and may be called concurrently by different
+// Executors. This call returns the input context.Context as-is if the input function is nil.
+func WithResultHandler(ctx context.Context, fn func(ExecResult)) context.Context {
+	if fn == nil {
+		return ctx
+	}
+
+	return context.WithValue(ctx, resultHandlerKey{}, fn)
+}
+
+func reportResult(ctx context.Context, result ExecResult) {
+	if fn, ok := ctx.Value(resultHandlerKey{}).(func(ExecResult)); ok {
+		fn(result)
+	}
+}
+
+type slotsKey struct{}
+
+// WithSlots returns a copy of the input context.Context carrying the input semaphore, which limits the number of
+// concurrent executions of the Executors called with that context.Context: an Executor acquires a slot (see AcquireSlot)
+// once its execution is due, and releases it once its runners are done. Waiting for the scheduled time does not take a
+// slot.
+//
+// This call returns the input context.Context as-is if the input semaphore is nil.
+func WithSlots(ctx context.Context, slots chan struct{}) context.Context {
+	if slots == nil {
+		return ctx
+	}
+
+	return context.WithValue(ctx, slotsKey{}, slots)
+}
+
+// AcquireSlot acquires a slot in the semaphore carried by the input context.Context (see WithSlots), waiting for one to
+// be available. It returns a function that releases the slot, or the context.Context's error if it is done before a
+// slot is available.
+//
+// If the context.Context carries no semaphore, AcquireSlot returns immediately with a no-op release function. Custom
+// Executors should call it right before running their task, to honor the limit.
+func AcquireSlot(ctx context.Context) (release func(), err error) {
+	slots, ok := ctx.Value(slotsKey{}).(chan struct{})
+	if !ok {
+		return func() {}, nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case slots <- struct{}{}:
+		return func() { <-slots }, nil
+	}
+}
@@ -4,6 +4,12 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"math/rand"
+	"reflect"
+	"slices"
+	"sort"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/zalgonoise/cfg"
@@ -16,21 +22,45 @@ import (
 )
 
 const (
-	cronAndLocAlloc = 2
-	defaultID       = "micron.executor"
-	bufferPeriod    = 100 * time.Millisecond
+	schedOptsAlloc = 6
+	defaultID      = "micron.executor"
+	bufferPeriod   = 100 * time.Millisecond
 
 	errDomain = errs.Domain("micron/executor")
 
-	ErrEmpty = errs.Kind("empty")
+	ErrEmpty     = errs.Kind("empty")
+	ErrExhausted = errs.Kind("exhausted")
+	ErrFailed    = errs.Kind("failed")
+	ErrInvalid   = errs.Kind("invalid")
 
 	ErrRunnerList = errs.Entity("runners list")
 	ErrScheduler  = errs.Entity("scheduler")
+	ErrCommand    = errs.Entity("command")
+	ErrRequest    = errs.Entity("HTTP request")
+)
+
+// Reasons for a skipped run, as registered with Metrics' IncExecutorSkippedRuns.
+const (
+	// SkipReasonOverlap marks a run skipped because a previous run is still in progress.
+	SkipReasonOverlap = "overlap"
+	// SkipReasonSampling marks a run skipped because it was not sampled to run.
+	SkipReasonSampling = "sampling"
+	// SkipReasonDisabled marks a run skipped because its Executor is disabled.
+	SkipReasonDisabled = "disabled"
+	// SkipReasonDryRun marks a run whose runners were not called because its Executor is in dry-run mode.
+	SkipReasonDryRun = "dry_run"
+	// SkipReasonPriority marks a run skipped because a higher priority Executor took its slot, in a blocking
+	// selector.Selector configured with priorities.
+	SkipReasonPriority = "priority"
 )
 
 var (
-	ErrEmptyRunnerList = errs.WithDomain(errDomain, ErrEmpty, ErrRunnerList)
-	ErrEmptyScheduler  = errs.WithDomain(errDomain, ErrEmpty, ErrScheduler)
+	ErrEmptyRunnerList    = errs.WithDomain(errDomain, ErrEmpty, ErrRunnerList)
+	ErrEmptyScheduler     = errs.WithDomain(errDomain, ErrEmpty, ErrScheduler)
+	ErrExhaustedScheduler = errs.WithDomain(errDomain, ErrExhausted, ErrScheduler)
+	ErrFailedCommand      = errs.WithDomain(errDomain, ErrFailed, ErrCommand)
+	ErrInvalidRequest     = errs.WithDomain(errDomain, ErrInvalid, ErrRequest)
+	ErrFailedRequest      = errs.WithDomain(errDomain, ErrFailed, ErrRequest)
 )
 
 // Runner describes a type that executes a job or task. It contains only one method, Run, that is called with a
@@ -38,7 +68,8 @@ var (
 //
 // Implementations of Runner only need to comply with this method, where the logic within Run is completely up to the
 // actual implementation. These implementations need to be aware of the state of the input context.Context, which may
-// denote cancellation or closure (e.g. with a timeout).
+// denote cancellation or closure (e.g. with a timeout). When called by an Executable, this context.Context also carries
+// the time that the execution was scheduled for, which is retrieved with ScheduledTime.
 //
 // The returned error denotes the success state of the execution. A nil error means that the execution was successful,
 // where a non-nil error must signal a failed execution.
@@ -71,6 +102,74 @@ func (r Runnable) Run(ctx context.Context) error {
 	return r(ctx)
 }
 
+// nonNilRunners returns the input Runner(s) that are not nil, including typed nil values like a nil Runnable or a nil
+// pointer to a Runner implementation.
+func nonNilRunners(runners []Runner) []Runner {
+	r := make([]Runner, 0, len(runners))
+
+	for i := range runners {
+		if isNilRunner(runners[i]) {
+			continue
+		}
+
+		r = append(r, runners[i])
+	}
+
+	return r
+}
+
+// isNilRunner returns true if the input Runner is nil, or if it holds a nil value of a nillable type (e.g. a nil
+// *Command), which would otherwise go unnoticed when comparing the Runner interface to nil.
+func isNilRunner(r Runner) bool {
+	if r == nil {
+		return true
+	}
+
+	switch v := reflect.ValueOf(r); v.Kind() {
+	case reflect.Pointer, reflect.Func, reflect.Map, reflect.Slice, reflect.Chan, reflect.Interface:
+		return v.IsNil()
+	default:
+		return false
+	}
+}
+
+// NamedRunner is a Runner identified by a name, exposed by its Name method.
+//
+// When a NamedRunner fails, the Executor attributes the error to it by wrapping it in a RunnerError, and labeling its
+// error metrics with the runner's name.
+type NamedRunner interface {
+	Runner
+	// Name returns this Runner's name.
+	Name() string
+}
+
+type namedRunner struct {
+	Runner
+
+	name string
+}
+
+// Name returns this Runner's name.
+func (r namedRunner) Name() string {
+	return r.name
+}
+
+// RunnerError is an error raised by a NamedRunner, carrying the name of the Runner that failed.
+type RunnerError struct {
+	Name string
+	Err  error
+}
+
+// Error implements the error interface.
+func (e *RunnerError) Error() string {
+	return "runner " + e.Name + ": " + e.Err.Error()
+}
+
+// Unwrap returns the error raised by the Runner.
+func (e *RunnerError) Unwrap() error {
+	return e.Err
+}
+
 // Executor describes the capabilities of cron job's executor component, which is based on fetching the next execution's
 // time, Next; as well as running the job, Exec. It also exposes an ID method to allow access to this Executor's
 // configured ID or name.
@@ -92,31 +191,98 @@ type Executor interface {
 	// For this, Exec leverages the Executor's underlying schedule.Scheduler to retrieve the job's next execution time,
 	// waits for it, and calls Runner.Run on each configured Runner. All raised errors are joined and returned at the end
 	// of this call.
+	//
+	// If the schedule.Scheduler has no further occurrences, Exec returns ErrExhaustedScheduler without running the task.
 	Exec(ctx context.Context) error
+	// RunNow runs the task immediately, regardless of its schedule.
+	//
+	// It calls Runner.Run on each configured Runner just like Exec, without waiting for the Executor's
+	// schedule.Scheduler. All raised errors are joined and returned at the end of this call.
+	RunNow(ctx context.Context) error
 	// Next calls the Executor's underlying schedule.Scheduler Next method.
+	//
+	// A zero time.Time value means that the Executor is done, and will not run its task again.
 	Next(ctx context.Context) time.Time
 	// ID returns this Executor's ID.
 	ID() string
 }
 
+// StatsExecutor is an Executor that keeps track of its runs, like the Executable, so that callers (like an operations
+// endpoint) are able to aggregate them without scraping its metrics.
+type StatsExecutor interface {
+	Executor
+
+	// LastRun returns the time that the Executor's latest run started, and the (joined) error it returned. A zero
+	// time.Time means that the Executor has not run yet.
+	LastRun() (time.Time, error)
+	// RunCount returns the number of times that the Executor ran its task.
+	RunCount() uint64
+}
+
 // Metrics describes the actions that register Executor-related metrics.
 type Metrics interface {
 	// IncExecutorExecCalls increases the count of Exec calls, by the Executor.
 	IncExecutorExecCalls(id string)
-	// IncExecutorExecErrors increases the count of Exec call errors, by the Executor.
-	IncExecutorExecErrors(id string)
+	// IncExecutorExecErrors increases the count of Exec call errors, by the Executor and the failing Runner's name.
+	//
+	// The runner name is empty for errors not raised by a NamedRunner.
+	IncExecutorExecErrors(id, runner string)
 	// ObserveExecLatency registers the duration of an Exec call, by the Executor.
 	ObserveExecLatency(ctx context.Context, id string, dur time.Duration)
+	// ObserveExecDrift registers how late the runners start in an Exec call, compared to their scheduled time, by the
+	// Executor.
+	ObserveExecDrift(ctx context.Context, id string, drift time.Duration)
 	// IncExecutorNextCalls increases the count of Next calls, by the Executor.
 	IncExecutorNextCalls(id string)
+	// IncExecutorRunErrors increases the count of failed Runner.Run attempts, by the Executor.
+	IncExecutorRunErrors(id string)
+	// IncExecutorSkippedRuns increases the count of triggers that did not run, by the Executor and the reason for the
+	// skip (one of SkipReasonOverlap, SkipReasonSampling, SkipReasonDisabled, SkipReasonDryRun or SkipReasonPriority).
+	IncExecutorSkippedRuns(id, reason string)
 }
 
 // Executable is an implementation of the Executor interface. It uses a schedule.Scheduler to mark the next job's
 // execution time, and supports multiple Runner.
 type Executable struct {
-	id      string
-	cron    schedule.Scheduler
-	runners []Runner
+	id              string
+	cron            schedule.Scheduler
+	runners         []Runner
+	parallelRunners bool
+	runTimeout      time.Duration
+	triggerBuffer   time.Duration
+
+	// expr is the cron string of the Executable's schedule.Scheduler, when available
+	expr string
+	// clock is the source of the current time when resolving and waiting for the next execution time
+	clock schedule.Clock
+
+	maxAttempts int
+	backoff     func(attempt int) time.Duration
+
+	skipIfRunning bool
+	running       atomic.Bool
+
+	// disabled is the inverse of the enabled state, so that the zero value is an enabled Executable
+	disabled atomic.Bool
+	// dryRun replaces the calls to the runners with a log entry
+	dryRun bool
+
+	missedRuns int
+	// lastMu guards lastScheduled, the scheduled time of the latest run from Exec, and claimed, the latest occurrence
+	// taken by an Exec call (even if it was then skipped); as well as the start time and error of the latest run
+	lastMu        sync.Mutex
+	lastScheduled time.Time
+	claimed       time.Time
+	lastRun       time.Time
+	lastErr       error
+	runCount      atomic.Uint64
+
+	beforeExec func(ctx context.Context, id string, scheduled time.Time)
+	afterExec  func(ctx context.Context, id string, err error, dur time.Duration)
+
+	maxJitter time.Duration
+	randMu    sync.Mutex
+	rand      *rand.Rand
 
 	logger  *slog.Logger
 	metrics Metrics
@@ -130,7 +296,7 @@ func (e *Executable) Next(ctx context.Context) time.Time {
 
 	e.metrics.IncExecutorNextCalls(e.id)
 
-	next := e.cron.Next(ctx, time.Now())
+	next := e.cron.Next(ctx, e.now())
 
 	e.logger.InfoContext(ctx, "next job",
 		slog.String("id", e.id),
@@ -150,13 +316,43 @@ func (e *Executable) Next(ctx context.Context) time.Time {
 // For this, Exec leverages the Executor's underlying schedule.Scheduler to retrieve the job's next execution time,
 // waits for it, and calls Runner.Run on each configured Runner. All raised errors are joined and returned at the end
 // of this call.
-func (e *Executable) Exec(ctx context.Context) error {
+//
+// If the schedule.Scheduler has no further occurrences, Exec returns ErrExhaustedScheduler without running the task.
+//
+// If the Executable is disabled (see SetEnabled), Exec returns nil immediately without running the task, registering
+// a skipped run.
+//
+// If the Executable is in dry-run mode (see WithDryRun), Exec goes through the same steps but logs the run instead of
+// calling the runners, and returns nil.
+//
+// Each scheduled time runs at most once: if concurrent Exec calls wait for the same occurrence (e.g. when a selector
+// detaches from a long wait and calls Exec again), only the first one runs the task, while the others return nil.
+func (e *Executable) Exec(ctx context.Context) (err error) {
+	runID := newRunID()
+	ctx = withRunID(ctx, runID)
+
 	ctx, span := e.tracer.Start(ctx, "Executor.Exec")
 	defer span.End()
 
-	span.SetAttributes(attribute.String("id", e.id))
+	span.SetAttributes(attribute.String("id", e.id), attribute.String("run_id", runID))
+
+	if e.dryRun {
+		span.SetAttributes(attribute.Bool("dry_run", true))
+	}
+
+	if !e.Enabled() {
+		span.AddEvent("skipped: executor is disabled")
+		e.metrics.IncExecutorSkippedRuns(e.id, SkipReasonDisabled)
+		e.logger.InfoContext(ctx, "skipping task execution, executor is disabled",
+			slog.String("id", e.id),
+			slog.String("run_id", runID),
+		)
+
+		return nil
+	}
+
 	e.metrics.IncExecutorExecCalls(e.id)
-	e.logger.InfoContext(ctx, "executing task", slog.String("id", e.id))
+	e.logger.InfoContext(ctx, "executing task", slog.String("id", e.id), slog.String("run_id", runID))
 
 	execCtx, cancel := context.WithCancel(ctx)
 	defer cancel()
@@ -164,61 +360,591 @@ func (e *Executable) Exec(ctx context.Context) error {
 	start := time.Now()
 
 	defer func() {
-		e.metrics.ObserveExecLatency(ctx, e.id, time.Since(start))
+		dur := time.Since(start)
+
+		span.SetAttributes(attribute.String("duration", dur.String()))
+		e.metrics.ObserveExecLatency(ctx, e.id, dur)
+
+		if e.afterExec != nil {
+			e.afterExec(ctx, e.id, err, dur)
+		}
 	}()
 
-	next := e.cron.Next(execCtx, start)
-	timer := time.NewTimer(next.Sub(start))
+	now := e.now()
+
+	if missed := e.missed(execCtx, now); len(missed) > 0 {
+		return e.catchUp(ctx, span, missed)
+	}
+
+	next := e.cron.Next(execCtx, now)
+	if next.IsZero() {
+		span.AddEvent("no further occurrences")
+		e.logger.InfoContext(ctx, "task has no further executions",
+			slog.String("id", e.id),
+			slog.String("run_id", runID),
+		)
+
+		return ErrExhaustedScheduler
+	}
+
+	at := next.Add(e.jitter(execCtx, span, next))
+	timer := time.NewTimer(at.Sub(now))
 
 	defer timer.Stop()
 
 	for {
 		select {
 		case <-ctx.Done():
-			err := ctx.Err()
+			err = ctx.Err()
 
-			e.metrics.IncExecutorExecErrors(e.id)
+			e.metrics.IncExecutorExecErrors(e.id, "")
 			span.RecordError(err)
 			span.SetStatus(codes.Error, err.Error())
 			e.logger.WarnContext(ctx, "task cancelled",
 				slog.String("id", e.id),
+				slog.String("run_id", runID),
 				slog.String("error", err.Error()),
 			)
 
 			return err
 
 		case <-timer.C:
-			// avoid executing before it's time, as it may trigger repeated runs
-			if preTriggerDuration := time.Since(next); preTriggerDuration > 0 {
-				time.Sleep(preTriggerDuration + bufferPeriod)
-			}
-
-			runnerErrs := make([]error, 0, len(e.runners))
-
-			for i := range e.runners {
-				if err := e.runners[i].Run(ctx); err != nil {
-					runnerErrs = append(runnerErrs, err)
-				}
-			}
-
-			if len(runnerErrs) > 0 {
-				err := errors.Join(runnerErrs...)
-
-				span.RecordError(err)
-				span.SetStatus(codes.Error, err.Error())
-				e.metrics.IncExecutorExecErrors(e.id)
-				e.logger.ErrorContext(ctx, "task execution error(s)",
-					slog.String("id", e.id),
-					slog.Int("num_errors", len(runnerErrs)),
-					slog.String("errors", err.Error()),
-				)
-
-				return err
-			}
+			return e.trigger(ctx, span, runID, next, at)
+		}
+	}
+}
+
+// trigger runs the task for the input next occurrence once its timer fires at the input (jittered) time, if no other
+// Exec call claimed it yet and no previous run is still in progress (when configured to skip overlapping runs).
+func (e *Executable) trigger(ctx context.Context, span trace.Span, runID string, next, at time.Time) (err error) {
+	// avoid executing before it's time, as it may trigger repeated runs
+	if preTriggerDuration := e.now().Sub(at); preTriggerDuration > 0 {
+		time.Sleep(preTriggerDuration + e.triggerBuffer)
+	}
+
+	// concurrent Exec calls (e.g. from a polling selector) may wait for the same occurrence, which only runs once
+	if !e.claim(next) {
+		span.AddEvent("skipped: occurrence already ran")
+		e.logger.DebugContext(ctx, "skipping task execution, occurrence already ran",
+			slog.String("id", e.id),
+			slog.String("run_id", runID),
+			slog.Time("scheduled", next),
+		)
+
+		return nil
+	}
+
+	e.consume(next)
+
+	if !e.start(ctx, span) {
+		return nil
+	}
+
+	defer e.done()
+
+	// only an actual run moves the boundary of the missed runs, not a skipped one
+	e.setLastScheduled(next)
+
+	var release func()
+
+	if release, err = e.acquire(ctx, span); err != nil {
+		return err
+	}
+
+	defer release()
+
+	// the drift is how late the runners start, compared to the (jittered) scheduled time
+	e.metrics.ObserveExecDrift(ctx, e.id, e.now().Sub(at))
+
+	if e.beforeExec != nil {
+		e.beforeExec(ctx, e.id, next)
+	}
+
+	return e.runScheduled(ctx, span, next)
+}
+
+// RunNow runs the task immediately, regardless of its schedule (e.g. to re-run a failed nightly job on demand).
+//
+// It calls Runner.Run on each configured Runner just like Exec, with the same retries, timeouts, hooks and metrics,
+// without waiting for the Executor's schedule.Scheduler. The scheduled time in the Runner's context.Context (see
+// ScheduledTime) is the time of the call, from the Executor's clock (see WithClock).
+//
+// RunNow is safe to call concurrently with Exec. If the Executor is configured to skip overlapping runs, RunNow
+// returns nil without running the task when a run is already in progress, and vice-versa.
+func (e *Executable) RunNow(ctx context.Context) (err error) {
+	runID := newRunID()
+	ctx = withRunID(ctx, runID)
+
+	ctx, span := e.tracer.Start(ctx, "Executor.RunNow")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("id", e.id), attribute.String("run_id", runID))
+
+	if e.dryRun {
+		span.SetAttributes(attribute.Bool("dry_run", true))
+	}
+	e.metrics.IncExecutorExecCalls(e.id)
+	e.logger.InfoContext(ctx, "running task now", slog.String("id", e.id), slog.String("run_id", runID))
+
+	start := time.Now()
+
+	defer func() {
+		dur := time.Since(start)
+
+		span.SetAttributes(attribute.String("duration", dur.String()))
+		e.metrics.ObserveExecLatency(ctx, e.id, dur)
+
+		if e.afterExec != nil {
+			e.afterExec(ctx, e.id, err, dur)
+		}
+	}()
+
+	if !e.start(ctx, span) {
+		return nil
+	}
+
+	defer e.done()
+
+	scheduled := e.now()
+
+	if e.beforeExec != nil {
+		e.beforeExec(ctx, e.id, scheduled)
+	}
+
+	return e.runScheduled(ctx, span, scheduled)
+}
+
+// missed returns the occurrences of the Executable's schedule that elapsed without running, after its last run and up
+// to the input time, in chronological order. Only the latest ones are returned, as limited by the Executable's
+// MissedRunPolicy.
+func (e *Executable) missed(ctx context.Context, now time.Time) []time.Time {
+	if e.missedRuns <= 0 {
+		return nil
+	}
+
+	e.lastMu.Lock()
+	last := e.lastScheduled
+	e.lastMu.Unlock()
+
+	if last.IsZero() {
+		return nil
+	}
+
+	missed := make([]time.Time, 0, e.missedRuns)
+
+	for t := now; len(missed) < e.missedRuns; {
+		prev := e.cron.Prev(ctx, t)
+		if prev.IsZero() || !prev.After(last) {
+			break
+		}
+
+		missed = append(missed, prev)
+		t = prev.Add(-time.Nanosecond)
+	}
+
+	slices.Reverse(missed)
+
+	return missed
+}
+
+// catchUp runs the Executable's runners once for each of the input missed occurrences, in order, returning their
+// joined errors.
+func (e *Executable) catchUp(ctx context.Context, span trace.Span, missed []time.Time) error {
+	if !e.start(ctx, span) {
+		return nil
+	}
+
+	defer e.done()
+
+	release, err := e.acquire(ctx, span)
+	if err != nil {
+		return err
+	}
+
+	defer release()
+
+	span.AddEvent("catching up on missed runs", trace.WithAttributes(attribute.Int("missed_runs", len(missed))))
+	e.logger.InfoContext(ctx, "catching up on missed runs",
+		slog.String("id", e.id),
+		slog.String("run_id", RunID(ctx)),
+		slog.Int("missed_runs", len(missed)),
+	)
+
+	runErrs := make([]error, 0, len(missed))
+
+	for i := range missed {
+		if err := ctx.Err(); err != nil {
+			return errors.Join(append(runErrs, err)...)
+		}
+
+		e.setLastScheduled(missed[i])
+
+		if e.beforeExec != nil {
+			e.beforeExec(ctx, e.id, missed[i])
+		}
+
+		if err := e.runScheduled(ctx, span, missed[i]); err != nil {
+			runErrs = append(runErrs, err)
+		}
+	}
+
+	return errors.Join(runErrs...)
+}
+
+// now returns the current time from the Executable's schedule.Clock, defaulting to time.Now if it is nil.
+func (e *Executable) now() time.Time {
+	if e.clock == nil {
+		return time.Now()
+	}
+
+	return e.clock.Now()
+}
+
+// setLastScheduled registers the input time as the scheduled time of the Executable's latest run.
+func (e *Executable) setLastScheduled(t time.Time) {
+	e.lastMu.Lock()
+	e.lastScheduled = t
+	e.lastMu.Unlock()
+}
+
+// claim registers the input time as the latest occurrence taken by an Exec call, returning false if it was already
+// registered. In that case, the occurrence was already taken (to run or to be skipped) and should not be taken again.
+func (e *Executable) claim(t time.Time) bool {
+	e.lastMu.Lock()
+	defer e.lastMu.Unlock()
 
+	if t.Equal(e.claimed) {
+		return false
+	}
+
+	e.claimed = t
+
+	return true
+}
+
+// consume marks the input occurrence as spent in the Executable's schedule.Scheduler, if it is a schedule.Consumer
+// (like an `@reboot` schedule, which only triggers once).
+func (e *Executable) consume(t time.Time) {
+	if c, ok := e.cron.(schedule.Consumer); ok {
+		c.Consume(t)
+	}
+}
+
+// LastRun returns the time that the Executable's latest run started, and the (joined) error it returned. A zero
+// time.Time means that the Executable has not run yet.
+//
+// Runs from both Exec and RunNow are considered, while skipped executions are not. It is safe to call concurrently with
+// Exec and RunNow.
+func (e *Executable) LastRun() (time.Time, error) {
+	e.lastMu.Lock()
+	defer e.lastMu.Unlock()
+
+	return e.lastRun, e.lastErr
+}
+
+// RunCount returns the number of times that the Executable ran its task, from both Exec and RunNow calls. It is safe to
+// call concurrently with Exec and RunNow.
+func (e *Executable) RunCount() uint64 {
+	return e.runCount.Load()
+}
+
+// SetEnabled enables or disables the Executable, which is enabled by default. It is safe to call concurrently with
+// Exec.
+//
+// A disabled Executable keeps its configuration, and its Next method still returns its scheduled times (e.g. for
+// display); though its Exec calls skip the task, so that a job can be paused (e.g. during a maintenance window) without
+// being removed from its selector. RunNow calls still run the task, as they are explicitly requested.
+func (e *Executable) SetEnabled(enabled bool) {
+	e.disabled.Store(!enabled)
+}
+
+// Enabled returns true if the Executable is enabled, as set with SetEnabled.
+func (e *Executable) Enabled() bool {
+	return !e.disabled.Load()
+}
+
+// start marks the Executable as running if it is configured to skip overlapping runs, returning false if a previous
+// run is still in progress. In that case, the run should be skipped.
+//
+// Executables that allow overlapping runs always return true.
+func (e *Executable) start(ctx context.Context, span trace.Span) bool {
+	if !e.skipIfRunning {
+		return true
+	}
+
+	// only one caller is able to swap the flag, even when in concurrent executor.Multi or RunNow calls
+	if e.running.CompareAndSwap(false, true) {
+		return true
+	}
+
+	span.AddEvent("skipped: previous run still in progress")
+	e.metrics.IncExecutorSkippedRuns(e.id, SkipReasonOverlap)
+	e.logger.WarnContext(ctx, "skipping task execution, previous run still in progress",
+		slog.String("id", e.id),
+		slog.String("run_id", RunID(ctx)),
+	)
+
+	return false
+}
+
+// acquire takes a slot in the semaphore carried by the input context.Context, if any (see WithSlots), returning the
+// function that releases it. It returns the context.Context's error if it is done while waiting for a slot.
+func (e *Executable) acquire(ctx context.Context, span trace.Span) (func(), error) {
+	release, err := AcquireSlot(ctx)
+	if err != nil {
+		e.metrics.IncExecutorExecErrors(e.id, "")
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		e.logger.WarnContext(ctx, "task cancelled while waiting for a slot",
+			slog.String("id", e.id),
+			slog.String("run_id", RunID(ctx)),
+			slog.String("error", err.Error()),
+		)
+
+		return nil, err
+	}
+
+	return release, nil
+}
+
+// done clears the running mark set by start.
+func (e *Executable) done() {
+	if e.skipIfRunning {
+		e.running.Store(false)
+	}
+}
+
+// jitter returns a random delay in [0, maxJitter) to add to the input next time, which never reaches the
+// schedule's following occurrence.
+func (e *Executable) jitter(ctx context.Context, span trace.Span, next time.Time) time.Duration {
+	if e.maxJitter <= 0 {
+		return 0
+	}
+
+	limit := e.maxJitter
+
+	if following := e.cron.Next(ctx, next); !following.IsZero() {
+		limit = min(limit, following.Sub(next))
+	}
+
+	if limit <= 0 {
+		return 0
+	}
+
+	e.randMu.Lock()
+	jitter := time.Duration(e.rand.Int63n(int64(limit)))
+	e.randMu.Unlock()
+
+	span.SetAttributes(attribute.String("jitter", jitter.String()))
+
+	return jitter
+}
+
+// runScheduled calls the Executable's runners for the input scheduled time, registering the run in the Executable's
+// stats (see LastRun and RunCount), and reporting its ExecResult to the result handler in the input context.Context, if
+// any. In dry-run mode, the runners are not called and the run is only logged.
+func (e *Executable) runScheduled(ctx context.Context, span trace.Span, scheduled time.Time) error {
+	var (
+		err   error
+		start = time.Now()
+	)
+
+	if e.dryRun {
+		e.logDryRun(ctx, span, scheduled)
+	} else {
+		err = e.runAll(withScheduledTime(ctx, scheduled), span)
+	}
+
+	e.runCount.Add(1)
+	e.lastMu.Lock()
+	e.lastRun = start
+	e.lastErr = err
+	e.lastMu.Unlock()
+
+	reportResult(ctx, ExecResult{
+		ID:        e.id,
+		RunID:     RunID(ctx),
+		Scheduled: scheduled,
+		Start:     start,
+		Duration:  time.Since(start),
+		Err:       err,
+		DryRun:    e.dryRun,
+	})
+
+	return err
+}
+
+// logDryRun registers a run for the input scheduled time whose runners were not called, as the Executable is in dry-run
+// mode.
+func (e *Executable) logDryRun(ctx context.Context, span trace.Span, scheduled time.Time) {
+	span.AddEvent("dry-run: runners not called")
+	e.metrics.IncExecutorSkippedRuns(e.id, SkipReasonDryRun)
+	e.logger.InfoContext(ctx, "dry-run would execute",
+		slog.String("id", e.id),
+		slog.String("run_id", RunID(ctx)),
+		slog.Time("scheduled", scheduled),
+		slog.Int("num_runners", len(e.runners)),
+	)
+}
+
+// runAll calls each of the Executable's runners, sequentially or in parallel, and joins any errors they raise in the
+// order the runners were configured.
+func (e *Executable) runAll(ctx context.Context, span trace.Span) error {
+	errs := make([]error, len(e.runners))
+
+	if e.parallelRunners {
+		wg := &sync.WaitGroup{}
+
+		for i := range e.runners {
+			wg.Add(1)
+
+			go func(i int) {
+				defer wg.Done()
+
+				errs[i] = e.runNamed(ctx, e.runners[i])
+			}(i)
+		}
+
+		wg.Wait()
+	} else {
+		for i := range e.runners {
+			errs[i] = e.runNamed(ctx, e.runners[i])
+		}
+	}
+
+	runnerErrs := make([]error, 0, len(errs))
+
+	for i := range errs {
+		if errs[i] != nil {
+			runnerErrs = append(runnerErrs, errs[i])
+		}
+	}
+
+	span.SetAttributes(
+		attribute.Int("num_runners", len(e.runners)),
+		attribute.Int("num_failed_runners", len(runnerErrs)),
+	)
+
+	if len(runnerErrs) > 0 {
+		err := errors.Join(runnerErrs...)
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		e.logger.ErrorContext(ctx, "task execution error(s)",
+			slog.String("id", e.id),
+			slog.String("run_id", RunID(ctx)),
+			slog.Int("num_errors", len(runnerErrs)),
+			slog.String("errors", err.Error()),
+		)
+
+		return err
+	}
+
+	return nil
+}
+
+// runNamed calls the input Runner, wrapping its error in a RunnerError if it is a NamedRunner.
+func (e *Executable) runNamed(ctx context.Context, runner Runner) error {
+	err := e.run(ctx, runner)
+	if err == nil {
+		return nil
+	}
+
+	name := runnerName(runner)
+	if name != "" {
+		err = &RunnerError{Name: name, Err: err}
+	}
+
+	e.metrics.IncExecutorExecErrors(e.id, name)
+
+	return err
+}
+
+func (e *Executable) run(ctx context.Context, runner Runner) error {
+	attempts := max(e.maxAttempts, 1)
+
+	for attempt := 1; ; attempt++ {
+		err := e.runOnce(ctx, runner)
+		if err == nil {
 			return nil
 		}
+
+		e.metrics.IncExecutorRunErrors(e.id)
+
+		if attempt >= attempts {
+			return err
+		}
+
+		e.logger.WarnContext(ctx, "runner attempt failed, retrying",
+			slog.String("id", e.id),
+			slog.String("run_id", RunID(ctx)),
+			slog.Int("attempt", attempt),
+			slog.String("error", err.Error()),
+		)
+
+		if e.backoff == nil {
+			continue
+		}
+
+		timer := time.NewTimer(e.backoff(attempt))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return errors.Join(err, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+func runnerName(runner Runner) string {
+	if named, ok := runner.(NamedRunner); ok {
+		return named.Name()
 	}
+
+	return ""
+}
+
+func namedRunners(runners map[string]Runner) []Runner {
+	names := make([]string, 0, len(runners))
+
+	for name := range runners {
+		if isNilRunner(runners[name]) {
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	// keep the runners' execution order deterministic
+	sort.Strings(names)
+
+	r := make([]Runner, 0, len(names))
+
+	for i := range names {
+		r = append(r, namedRunner{Runner: runners[names[i]], name: names[i]})
+	}
+
+	return r
+}
+
+func (e *Executable) runOnce(ctx context.Context, runner Runner) error {
+	if e.runTimeout <= 0 {
+		return runner.Run(ctx)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, e.runTimeout)
+	defer cancel()
+
+	return runner.Run(ctx)
+}
+
+// Expr returns the cron string of this Executor's schedule.Scheduler, as supplied with the WithSchedule option, or
+// from a schedule.CronSchedule's Expr field when supplied with the WithScheduler option. An empty string is returned
+// if the schedule.Scheduler was not created from a cron string.
+func (e *Executable) Expr() string {
+	return e.expr
 }
 
 // ID returns this Executor's ID.
@@ -245,6 +971,9 @@ func newExecutable(id string, config *Config) (Executor, error) {
 		id = defaultID
 	}
 
+	// nil runners would panic when executed, so they are filtered out
+	config.runners = nonNilRunners(config.runners)
+
 	if len(config.runners) == 0 {
 		return noOpExecutor{}, ErrEmptyRunnerList
 	}
@@ -253,15 +982,24 @@ func newExecutable(id string, config *Config) (Executor, error) {
 		return noOpExecutor{}, ErrEmptyScheduler
 	}
 
-	var sched schedule.Scheduler
+	var (
+		sched schedule.Scheduler
+		expr  string
+	)
 
 	switch {
 	case config.scheduler != nil:
-		// scheduler is provided, ignore cron string and location
+		// scheduler is provided, ignore cron string, location, seconds, alignment and week start
 		sched = config.scheduler
+
+		if cron, ok := sched.(*schedule.CronSchedule); ok {
+			expr = cron.Expr
+		}
 	default:
+		expr = config.cron
+
 		// create a new scheduler from config
-		opts := make([]cfg.Option[schedule.Config], 0, cronAndLocAlloc)
+		opts := make([]cfg.Option[schedule.Config], 0, schedOptsAlloc)
 
 		if config.cron != "" {
 			opts = append(opts, schedule.WithSchedule(config.cron))
@@ -271,6 +1009,22 @@ func newExecutable(id string, config *Config) (Executor, error) {
 			opts = append(opts, schedule.WithLocation(config.loc))
 		}
 
+		if config.secondsDisabled {
+			opts = append(opts, schedule.WithSecondsDisabled())
+		}
+
+		if config.aligned {
+			opts = append(opts, schedule.WithAlignment(true))
+		}
+
+		if config.weekStart != time.Sunday {
+			opts = append(opts, schedule.WithWeekStart(config.weekStart))
+		}
+
+		if config.clock != nil {
+			opts = append(opts, schedule.WithClock(config.clock))
+		}
+
 		var err error
 
 		sched, err = schedule.New(opts...)
@@ -279,11 +1033,33 @@ func newExecutable(id string, config *Config) (Executor, error) {
 		}
 	}
 
+	if config.offset != 0 {
+		sched = schedule.Offset(sched, config.offset)
+	}
+
 	// return the object with the provided runners
 	return &Executable{
-		id:      id,
-		cron:    sched,
-		runners: config.runners,
+		id:              id,
+		cron:            sched,
+		expr:            expr,
+		clock:           config.clock,
+		runners:         config.runners,
+		parallelRunners: config.parallelRunners,
+		runTimeout:      config.runTimeout,
+		triggerBuffer:   config.triggerBuffer,
+
+		maxAttempts: config.maxAttempts,
+		backoff:     config.backoff,
+
+		skipIfRunning: config.skipIfRunning,
+		missedRuns:    config.missedRuns.limit,
+		dryRun:        config.dryRun,
+
+		beforeExec: config.beforeExec,
+		afterExec:  config.afterExec,
+
+		maxJitter: config.maxJitter,
+		rand:      newRand(config.randSource),
 
 		logger:  slog.New(config.handler),
 		metrics: config.metrics,
@@ -291,6 +1067,16 @@ func newExecutable(id string, config *Config) (Executor, error) {
 	}, nil
 }
 
+func newRand(src rand.Source) *rand.Rand {
+	if src == nil {
+		//nolint:gosec // jitter is meant to spread load, it does not require a cryptographically secure generator
+		return rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+
+	//nolint:gosec // jitter is meant to spread load, it does not require a cryptographically secure generator
+	return rand.New(src)
+}
+
 // NoOp returns a no-op Executor.
 func NoOp() Executor {
 	return noOpExecutor{}
@@ -305,6 +1091,13 @@ func (e noOpExecutor) Exec(context.Context) error {
 	return nil
 }
 
+// RunNow runs the task immediately, regardless of its schedule.
+//
+// This is a no-op call, it has no effect and the returned error is always nil.
+func (e noOpExecutor) RunNow(context.Context) error {
+	return nil
+}
+
 // Next calls the Executor's underlying schedule.Scheduler Next method.
 //
 // This is a no-op call, it has no effect and the returned time is always zero.
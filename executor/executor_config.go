@@ -1,7 +1,9 @@
 package executor
 
 import (
+	"context"
 	"log/slog"
+	"math/rand"
 	"time"
 
 	"github.com/zalgonoise/cfg"
@@ -17,8 +19,30 @@ type Config struct {
 	scheduler schedule.Scheduler
 	cron      string
 	loc       *time.Location
+	offset    time.Duration
 
-	runners []Runner
+	secondsDisabled bool
+	aligned         bool
+	weekStart       time.Weekday
+	clock           schedule.Clock
+
+	runners         []Runner
+	parallelRunners bool
+	runTimeout      time.Duration
+	triggerBuffer   time.Duration
+
+	maxAttempts int
+	backoff     func(attempt int) time.Duration
+
+	skipIfRunning bool
+	dryRun        bool
+	missedRuns    MissedRunPolicy
+
+	beforeExec func(ctx context.Context, id string, scheduled time.Time)
+	afterExec  func(ctx context.Context, id string, err error, dur time.Duration)
+
+	maxJitter  time.Duration
+	randSource rand.Source
 
 	handler slog.Handler
 	metrics Metrics
@@ -27,9 +51,10 @@ type Config struct {
 
 func defaultConfig() *Config {
 	return &Config{
-		handler: log.NoOp(),
-		metrics: metrics.NoOp(),
-		tracer:  noop.NewTracerProvider().Tracer("executor's no-op tracer"),
+		triggerBuffer: bufferPeriod,
+		handler:       log.NoOp(),
+		metrics:       metrics.NoOp(),
+		tracer:        noop.NewTracerProvider().Tracer("executor's no-op tracer"),
 	}
 }
 
@@ -38,15 +63,7 @@ func defaultConfig() *Config {
 // This call returns a cfg.NoOp cfg.Option if no runners are provided, or if the ones provided are all
 // nil. Any nil Runner or Runnable will be ignored.
 func WithRunners(runners ...Runner) cfg.Option[*Config] {
-	r := make([]Runner, 0, len(runners))
-
-	for i := range runners {
-		if runners[i] == nil {
-			continue
-		}
-
-		r = append(r, runners[i])
-	}
+	r := nonNilRunners(runners)
 
 	if len(r) == 0 {
 		return cfg.NoOp[*Config]{}
@@ -65,6 +82,245 @@ func WithRunners(runners ...Runner) cfg.Option[*Config] {
 	})
 }
 
+// WithNamedRunners configures the Executor with the input Runner(s), identified by their names in the input map.
+//
+// Errors raised by these runners are wrapped in a RunnerError with the runner's name, which also labels the
+// Executor's error metrics. The runners are executed in the lexical order of their names.
+//
+// This call returns a cfg.NoOp cfg.Option if no runners are provided, or if the ones provided are all
+// nil. Any nil Runner or Runnable will be ignored.
+func WithNamedRunners(runners map[string]Runner) cfg.Option[*Config] {
+	return WithRunners(namedRunners(runners)...)
+}
+
+// WithRunTimeout configures the Executor to limit each Runner.Run call to the input time.Duration, by deriving its
+// context.Context with context.WithTimeout.
+//
+// A Runner exceeding this timeout has its error recorded like any other, while the remaining runners in the same Exec
+// call still run.
+//
+// This call returns a cfg.NoOp cfg.Option if the input duration is zero or below, which disables the timeout.
+func WithRunTimeout(dur time.Duration) cfg.Option[*Config] {
+	if dur <= 0 {
+		return cfg.NoOp[*Config]{}
+	}
+
+	return cfg.Register(func(config *Config) *Config {
+		config.runTimeout = dur
+
+		return config
+	})
+}
+
+// WithParallelRunners configures the Executor to call all of its runners concurrently in an Exec (or RunNow) call,
+// waiting for all of them to return.
+//
+// By default, the runners are called sequentially, in the order they were configured, which suits pipelines where a
+// Runner depends on the previous one. Independent runners can use this option to run in parallel instead.
+//
+// In both modes, a failing Runner does not prevent the remaining ones from running; and their errors are joined in the
+// order the runners were configured, regardless of the order in which they failed.
+func WithParallelRunners() cfg.Option[*Config] {
+	return cfg.Register(func(config *Config) *Config {
+		config.parallelRunners = true
+
+		return config
+	})
+}
+
+// WithRetry configures the Executor to retry a failing Runner, calling its Run method up to maxAttempts times in total.
+//
+// The input backoff function returns the time.Duration to wait for before the next attempt, from the (1-indexed)
+// number of the attempt that failed. A nil backoff function retries immediately. Waiting for the backoff is
+// interrupted if the Exec call's context.Context is done.
+//
+// Each failed attempt is registered in the Executor's Metrics, while the Exec call only returns the error once the
+// attempts are exhausted.
+//
+// This call returns a cfg.NoOp cfg.Option if maxAttempts is one or below, as there would be no retries.
+func WithRetry(maxAttempts int, backoff func(attempt int) time.Duration) cfg.Option[*Config] {
+	if maxAttempts <= 1 {
+		return cfg.NoOp[*Config]{}
+	}
+
+	return cfg.Register(func(config *Config) *Config {
+		config.maxAttempts = maxAttempts
+		config.backoff = backoff
+
+		return config
+	})
+}
+
+// WithSkipIfRunning configures the Executor to skip a trigger if the previous run of its task is still in progress,
+// preventing overlapping executions of long-running tasks.
+//
+// Skipped triggers are logged and registered in the Executor's Metrics, and do not return an error from Exec.
+func WithSkipIfRunning() cfg.Option[*Config] {
+	return cfg.Register(func(config *Config) *Config {
+		config.skipIfRunning = true
+
+		return config
+	})
+}
+
+// MissedRunPolicy defines how an Executor handles the occurrences of its schedule that elapsed without running since
+// its last run, like when the process was paused or asleep. It is one of SkipAll, RunOnce or RunAll.
+type MissedRunPolicy struct {
+	// limit is the maximum number of missed occurrences to run, where zero skips them all
+	limit int
+}
+
+// maxMissedRuns caps the number of missed occurrences that a RunAll MissedRunPolicy runs, avoiding a flood of
+// executions after a long outage.
+const maxMissedRuns = 100
+
+//nolint:gochecknoglobals // immutable MissedRunPolicy values, used like an enum
+var (
+	// SkipAll skips all missed occurrences, so that the Executor only runs its following occurrence. This is the default
+	// MissedRunPolicy.
+	SkipAll = MissedRunPolicy{}
+	// RunOnce runs the latest missed occurrence once, skipping any other ones.
+	RunOnce = MissedRunPolicy{limit: 1}
+)
+
+// RunAll returns a MissedRunPolicy that runs each missed occurrence, in order, up to the latest limit ones.
+//
+// The limit is capped to 100 occurrences, which is also used when the input limit is zero or below.
+func RunAll(limit int) MissedRunPolicy {
+	if limit <= 0 || limit > maxMissedRuns {
+		limit = maxMissedRuns
+	}
+
+	return MissedRunPolicy{limit: limit}
+}
+
+// WithMissedRunPolicy configures how the Executor handles the occurrences of its schedule that elapsed without running
+// since its last run (e.g. when the process was paused or asleep), with SkipAll, RunOnce or RunAll.
+//
+// Missed occurrences are found when Exec is called, by going through the schedule.Scheduler's previous occurrences
+// until the scheduled time of the Executor's last run. They are run with their own scheduled times (see ScheduledTime)
+// and Exec returns once they are done, so that the following occurrence is executed on the following Exec call.
+//
+// Only the occurrences after the first run of the Executor can be missed. By default, all missed occurrences are
+// skipped (SkipAll).
+func WithMissedRunPolicy(policy MissedRunPolicy) cfg.Option[*Config] {
+	return cfg.Register(func(config *Config) *Config {
+		config.missedRuns = policy
+
+		return config
+	})
+}
+
+// WithBeforeExec configures the Executor with a hook that is called right before its runners are executed, with the
+// Executor's ID and the time the execution was scheduled for.
+//
+// This call returns a cfg.NoOp cfg.Option if the input function is nil.
+func WithBeforeExec(fn func(ctx context.Context, id string, scheduled time.Time)) cfg.Option[*Config] {
+	if fn == nil {
+		return cfg.NoOp[*Config]{}
+	}
+
+	return cfg.Register(func(config *Config) *Config {
+		config.beforeExec = fn
+
+		return config
+	})
+}
+
+// WithAfterExec configures the Executor with a hook that is called at the end of each Exec call, with the Executor's
+// ID, the (joined) error returned by Exec, and the duration of the call.
+//
+// This call returns a cfg.NoOp cfg.Option if the input function is nil.
+func WithAfterExec(fn func(ctx context.Context, id string, err error, dur time.Duration)) cfg.Option[*Config] {
+	if fn == nil {
+		return cfg.NoOp[*Config]{}
+	}
+
+	return cfg.Register(func(config *Config) *Config {
+		config.afterExec = fn
+
+		return config
+	})
+}
+
+// WithJitter configures the Executor to delay each execution by a random duration in [0, maxJitter), spreading the
+// load of executors that share the same schedule.
+//
+// The jitter never pushes an execution past the following occurrence of the schedule.
+//
+// This call returns a cfg.NoOp cfg.Option if the input duration is zero or below, which disables the jitter.
+func WithJitter(maxJitter time.Duration) cfg.Option[*Config] {
+	if maxJitter <= 0 {
+		return cfg.NoOp[*Config]{}
+	}
+
+	return cfg.Register(func(config *Config) *Config {
+		config.maxJitter = maxJitter
+
+		return config
+	})
+}
+
+// WithJitterSource configures the rand.Source used to generate the jitter configured with the WithJitter option,
+// which is useful for reproducible results.
+//
+// This call returns a cfg.NoOp cfg.Option if the input rand.Source is nil.
+func WithJitterSource(src rand.Source) cfg.Option[*Config] {
+	if src == nil {
+		return cfg.NoOp[*Config]{}
+	}
+
+	return cfg.Register(func(config *Config) *Config {
+		config.randSource = src
+
+		return config
+	})
+}
+
+// WithClock configures the schedule.Clock used by the Executor to get the current time, when resolving its next
+// execution time and waiting for it. The default schedule.Clock uses time.Now.
+//
+// It allows simulating the passage of time (e.g. in tests), where a fake schedule.Clock decides when each execution is
+// due. To keep the Executor consistent with its selector, the same clock should be configured in both (see
+// selector.WithClock). The Executor still waits for its executions in real time, for the duration between the clock's
+// current time and the scheduled time.
+//
+// When using the WithSchedule option, the schedule.Scheduler is also configured with the input schedule.Clock.
+//
+// This call returns a cfg.NoOp cfg.Option if the input schedule.Clock is nil.
+func WithClock(clock schedule.Clock) cfg.Option[*Config] {
+	if clock == nil {
+		return cfg.NoOp[*Config]{}
+	}
+
+	return cfg.Register(func(config *Config) *Config {
+		config.clock = clock
+
+		return config
+	})
+}
+
+// WithTriggerBuffer configures the extra delay that the Executor adds to an execution whose timer fires off its
+// scheduled time, which is 100ms by default.
+//
+// This buffer protects against early fires caused by clock and timer imprecision, which could otherwise execute a
+// task twice for the same trigger. A larger buffer is safer on systems with coarse timers, at the cost of added
+// latency to these executions; while a smaller one (or zero, which disables it) lowers that latency but relies on
+// precise timers.
+//
+// This call returns a cfg.NoOp cfg.Option if the input duration is negative.
+func WithTriggerBuffer(dur time.Duration) cfg.Option[*Config] {
+	if dur < 0 {
+		return cfg.NoOp[*Config]{}
+	}
+
+	return cfg.Register(func(config *Config) *Config {
+		config.triggerBuffer = dur
+
+		return config
+	})
+}
+
 // WithScheduler configures the Executor with the input schedule.Scheduler.
 //
 // This call returns a cfg.NoOp cfg.Option if the input schedule.Scheduler is either nil or a no-op.
@@ -86,6 +342,9 @@ func WithScheduler(sched schedule.Scheduler) cfg.Option[*Config] {
 //
 // This call returns a cfg.NoOp cfg.Option if the cron string is empty.
 //
+// A location embedded in the cron string with a `TZ=` or `CRON_TZ=` prefix is honored by the schedule.Scheduler,
+// taking precedence over the WithLocation option.
+//
 // This option can be followed by a WithLocation option.
 func WithSchedule(cron string) cfg.Option[*Config] {
 	if cron == "" {
@@ -99,6 +358,24 @@ func WithSchedule(cron string) cfg.Option[*Config] {
 	})
 }
 
+// WithScheduleOffset shifts each occurrence of the Executor's schedule.Scheduler by the input duration, wrapping it with
+// schedule.Offset. This is useful for jobs that depend on another one, running a fixed duration after it (e.g. five
+// minutes after an hourly job). Negative durations bring the occurrences forward.
+//
+// This option applies to both the WithSchedule and WithScheduler options. This call returns a cfg.NoOp cfg.Option if
+// the input duration is zero.
+func WithScheduleOffset(offset time.Duration) cfg.Option[*Config] {
+	if offset == 0 {
+		return cfg.NoOp[*Config]{}
+	}
+
+	return cfg.Register(func(config *Config) *Config {
+		config.offset = offset
+
+		return config
+	})
+}
+
 // WithLocation configures the Executor's schedule.Scheduler with the input time.Location.
 //
 // This call returns a cfg.NoOp cfg.Option if the input time.Location is nil.
@@ -117,6 +394,73 @@ func WithLocation(loc *time.Location) cfg.Option[*Config] {
 	})
 }
 
+// WithSecondsDisabled configures the Executor's schedule.Scheduler to ignore the seconds field of its cron string,
+// always triggering on the first second of a minute (like classic cron).
+//
+// Using this option implies using the WithSchedule option, as it means the caller is creating a
+// schedule from a cron string, instead of passing a schedule.Scheduler with the WithScheduler option.
+func WithSecondsDisabled() cfg.Option[*Config] {
+	return cfg.Register(func(config *Config) *Config {
+		config.secondsDisabled = true
+
+		return config
+	})
+}
+
+// WithAlignment configures whether the occurrences of the Executor's `@every` interval schedule are aligned to the wall
+// clock, as described in schedule.WithAlignment. For example, with a job created at 10:02, `@every 5m` first triggers
+// at 10:05 if aligned, or at 10:07 otherwise, which is the default.
+//
+// Cron schedules are always aligned to their fields, so this option only affects `@every` interval schedules. An
+// aligned interval stays on the wall clock's boundaries on every run, while an unaligned one is anchored on its first
+// occurrence: each following occurrence is the previous one advanced by the interval, regardless of when the Executor
+// is called (e.g. 10:07, 10:12, 10:17 and so on).
+//
+// Using this option implies using the WithSchedule option, as it means the caller is creating a
+// schedule from a cron string, instead of passing a schedule.Scheduler with the WithScheduler option.
+func WithAlignment(aligned bool) cfg.Option[*Config] {
+	return cfg.Register(func(config *Config) *Config {
+		config.aligned = aligned
+
+		return config
+	})
+}
+
+// WithDryRun configures the Executor to go through its scheduling, waiting, logging, tracing and metrics as usual, but to
+// log each run with a "dry-run would execute" message instead of calling its Runner(s). It is useful to validate a
+// new schedule against real time without any side effects, before enabling the actual work.
+//
+// Dry runs return a nil error and are distinguishable from actual runs: their trace spans have a `dry_run` attribute,
+// they are registered as skipped runs with the SkipReasonDryRun reason, and their ExecResult has DryRun set. They are
+// still counted in the Executor's RunCount and LastRun.
+func WithDryRun() cfg.Option[*Config] {
+	return cfg.Register(func(config *Config) *Config {
+		config.dryRun = true
+
+		return config
+	})
+}
+
+// WithWeekStart configures the day that numeric values in the days-of-the-week field of the Executor's cron string are
+// counted from, as described in schedule.WithWeekStart. It is Sunday by default; with a time.Monday week start, `0-4`
+// means Monday to Friday.
+//
+// This call returns a cfg.NoOp cfg.Option if the input time.Weekday is not a valid day of the week.
+//
+// Using this option implies using the WithSchedule option, as it means the caller is creating a
+// schedule from a cron string, instead of passing a schedule.Scheduler with the WithScheduler option.
+func WithWeekStart(start time.Weekday) cfg.Option[*Config] {
+	if start < time.Sunday || start > time.Saturday {
+		return cfg.NoOp[*Config]{}
+	}
+
+	return cfg.Register(func(config *Config) *Config {
+		config.weekStart = start
+
+		return config
+	})
+}
+
 // WithMetrics decorates the Executor with the input metrics registry.
 func WithMetrics(m Metrics) cfg.Option[*Config] {
 	if m == nil {
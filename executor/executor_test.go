@@ -1,15 +1,23 @@
 package executor
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"io"
 	"log/slog"
+	"math/rand"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/zalgonoise/cfg"
 	"github.com/zalgonoise/x/is"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"go.opentelemetry.io/otel/trace"
 	"go.opentelemetry.io/otel/trace/noop"
 
@@ -21,7 +29,38 @@ import (
 
 type testScheduler struct{}
 
-func (testScheduler) Next(context.Context, time.Time) time.Time { return time.Time{} }
+func (testScheduler) Next(context.Context, time.Time) time.Time      { return time.Time{} }
+func (testScheduler) Prev(context.Context, time.Time) time.Time      { return time.Time{} }
+func (testScheduler) Until(context.Context, time.Time) time.Duration { return schedule.Never }
+
+type nowScheduler struct{}
+
+func (nowScheduler) Next(_ context.Context, t time.Time) time.Time  { return t }
+func (nowScheduler) Prev(_ context.Context, t time.Time) time.Time  { return t }
+func (nowScheduler) Until(context.Context, time.Time) time.Duration { return 0 }
+
+type errCounter struct {
+	metrics.Metrics
+
+	errors    int
+	runners   []string
+	runErrors int
+	skipped   atomic.Int32
+	drifts    []time.Duration
+}
+
+func (m *errCounter) IncExecutorExecErrors(_, runner string) {
+	m.errors++
+	m.runners = append(m.runners, runner)
+}
+
+func (m *errCounter) IncExecutorRunErrors(string) { m.runErrors++ }
+
+func (m *errCounter) IncExecutorSkippedRuns(string, string) { m.skipped.Add(1) }
+
+func (m *errCounter) ObserveExecDrift(_ context.Context, _ string, drift time.Duration) {
+	m.drifts = append(m.drifts, drift)
+}
 
 func TestConfig(t *testing.T) {
 	runner := Runnable(func(context.Context) error {
@@ -58,6 +97,144 @@ func TestConfig(t *testing.T) {
 				WithRunners(runner),
 			},
 		},
+		{
+			name: "WithNamedRunners/NoRunners",
+			opts: []cfg.Option[*Config]{
+				WithNamedRunners(nil),
+			},
+		},
+		{
+			name: "WithNamedRunners/NilRunner",
+			opts: []cfg.Option[*Config]{
+				WithNamedRunners(map[string]Runner{"nil": nil}),
+			},
+		},
+		{
+			name: "WithNamedRunners/TypedNilRunner",
+			opts: []cfg.Option[*Config]{
+				WithNamedRunners(map[string]Runner{"nil": (*Command)(nil), "runnable": Runnable(nil)}),
+			},
+		},
+		{
+			name: "WithNamedRunners/OneRunner",
+			opts: []cfg.Option[*Config]{
+				WithNamedRunners(map[string]Runner{"runner": runner}),
+			},
+		},
+		{
+			name: "WithRunTimeout/Zero",
+			opts: []cfg.Option[*Config]{
+				WithRunTimeout(0),
+			},
+		},
+		{
+			name: "WithRunTimeout/Negative",
+			opts: []cfg.Option[*Config]{
+				WithRunTimeout(-time.Second),
+			},
+		},
+		{
+			name: "WithRunTimeout/OneSecond",
+			opts: []cfg.Option[*Config]{
+				WithRunTimeout(time.Second),
+			},
+		},
+		{
+			name: "WithRetry/SingleAttempt",
+			opts: []cfg.Option[*Config]{
+				WithRetry(1, nil),
+			},
+		},
+		{
+			name: "WithRetry/ThreeAttempts",
+			opts: []cfg.Option[*Config]{
+				WithRetry(3, func(int) time.Duration { return time.Millisecond }),
+			},
+		},
+		{
+			name: "WithParallelRunners",
+			opts: []cfg.Option[*Config]{
+				WithParallelRunners(),
+			},
+		},
+		{
+			name: "WithSkipIfRunning",
+			opts: []cfg.Option[*Config]{
+				WithSkipIfRunning(),
+			},
+		},
+		{
+			name: "WithBeforeExec/NilHook",
+			opts: []cfg.Option[*Config]{
+				WithBeforeExec(nil),
+			},
+		},
+		{
+			name: "WithBeforeExec/OneHook",
+			opts: []cfg.Option[*Config]{
+				WithBeforeExec(func(context.Context, string, time.Time) {}),
+			},
+		},
+		{
+			name: "WithAfterExec/NilHook",
+			opts: []cfg.Option[*Config]{
+				WithAfterExec(nil),
+			},
+		},
+		{
+			name: "WithAfterExec/OneHook",
+			opts: []cfg.Option[*Config]{
+				WithAfterExec(func(context.Context, string, error, time.Duration) {}),
+			},
+		},
+		{
+			name: "WithJitter/Zero",
+			opts: []cfg.Option[*Config]{
+				WithJitter(0),
+			},
+		},
+		{
+			name: "WithJitter/OneSecond",
+			opts: []cfg.Option[*Config]{
+				WithJitter(time.Second),
+			},
+		},
+		{
+			name: "WithJitterSource/NilSource",
+			opts: []cfg.Option[*Config]{
+				WithJitterSource(nil),
+			},
+		},
+		{
+			name: "WithJitterSource/OneSource",
+			opts: []cfg.Option[*Config]{
+				WithJitterSource(rand.NewSource(1)),
+			},
+		},
+		{
+			name: "WithClock/NilClock",
+			opts: []cfg.Option[*Config]{
+				WithClock(nil),
+			},
+		},
+		{
+			name: "WithClock/OK",
+			opts: []cfg.Option[*Config]{
+				WithClock(fixedClock(time.Now())),
+			},
+		},
+		{
+			name: "WithTriggerBuffer/Negative",
+			opts: []cfg.Option[*Config]{
+				WithTriggerBuffer(-time.Millisecond),
+			},
+		},
+		{
+			name: "WithTriggerBuffer/Zero",
+			opts: []cfg.Option[*Config]{
+				WithTriggerBuffer(0),
+			},
+		},
 		{
 			name: "WithScheduler/NoScheduler",
 			opts: []cfg.Option[*Config]{
@@ -94,6 +271,12 @@ func TestConfig(t *testing.T) {
 				WithLocation(time.Local),
 			},
 		},
+		{
+			name: "WithSecondsDisabled",
+			opts: []cfg.Option[*Config]{
+				WithSecondsDisabled(),
+			},
+		},
 		{
 			name: "WithMetrics/NilMetrics",
 			opts: []cfg.Option[*Config]{
@@ -425,6 +608,7 @@ func TestNoOp(t *testing.T) {
 	is.Equal(t, time.Time{}, noOp.Next(context.Background()))
 	is.Equal(t, "", noOp.ID())
 	is.Empty(t, noOp.Exec(context.Background()))
+	is.Empty(t, noOp.RunNow(context.Background()))
 }
 
 func TestNew(t *testing.T) {
@@ -457,6 +641,14 @@ func TestNew(t *testing.T) {
 			},
 			err: cronlex.ErrInvalidFrequency,
 		},
+		{
+			name: "OnlyNilRunners",
+			conf: []cfg.Option[*Config]{
+				WithRunners([]Runner{nil, Runnable(nil), (*Command)(nil), (*HTTPRunner)(nil)}...),
+				WithSchedule("* * * * *"),
+			},
+			err: ErrEmptyRunnerList,
+		},
 	} {
 		t.Run(testcase.name, func(t *testing.T) {
 			_, err := New(testcase.name, testcase.conf...)
@@ -464,3 +656,1326 @@ func TestNew(t *testing.T) {
 		})
 	}
 }
+
+func TestNew_NilRunners(t *testing.T) {
+	var runs atomic.Int32
+
+	exec, err := New("nil-runners",
+		WithScheduler(nowScheduler{}),
+		WithRunners(
+			nil,
+			Runnable(func(context.Context) error {
+				runs.Add(1)
+
+				return nil
+			}),
+			(*Command)(nil),
+			Runnable(nil),
+		),
+	)
+	is.Empty(t, err)
+
+	// the nil runners are filtered out, instead of panicking when executed
+	is.Equal(t, 1, len(exec.(*Executable).runners))
+	is.Empty(t, exec.Exec(context.Background()))
+	is.Equal(t, int32(1), runs.Load())
+}
+
+func TestNew_NilNamedRunners(t *testing.T) {
+	var runs atomic.Int32
+
+	exec, err := New("nil-named-runners",
+		WithScheduler(nowScheduler{}),
+		WithNamedRunners(map[string]Runner{
+			"command": (*Command)(nil),
+			"http":    (*HTTPRunner)(nil),
+			"nil":     nil,
+			"ok": Runnable(func(context.Context) error {
+				runs.Add(1)
+
+				return nil
+			}),
+			"runnable": Runnable(nil),
+		}),
+	)
+	is.Empty(t, err)
+
+	// the typed-nil runners are filtered out, instead of panicking when executed
+	is.Equal(t, 1, len(exec.(*Executable).runners))
+	is.Empty(t, exec.Exec(context.Background()))
+	is.Empty(t, exec.RunNow(context.Background()))
+	is.Equal(t, int32(2), runs.Load())
+}
+
+func TestExecutable_ExecExhausted(t *testing.T) {
+	var runs int
+
+	exec, err := New("exhausted",
+		WithScheduler(testScheduler{}),
+		WithRunners(Runnable(func(context.Context) error {
+			runs++
+
+			return nil
+		})),
+	)
+	is.Empty(t, err)
+
+	is.True(t, exec.Next(context.Background()).IsZero())
+	is.True(t, errors.Is(exec.Exec(context.Background()), ErrExhaustedScheduler))
+	is.Equal(t, 0, runs)
+}
+
+func TestExecutable_ExecRunTimeout(t *testing.T) {
+	const timeout = 50 * time.Millisecond
+
+	var completed bool
+
+	m := &errCounter{Metrics: metrics.NoOp()}
+
+	exec, err := New("timeout",
+		WithScheduler(nowScheduler{}),
+		WithRunTimeout(timeout),
+		WithMetrics(m),
+		WithRunners(
+			Runnable(func(ctx context.Context) error {
+				<-ctx.Done()
+
+				return ctx.Err()
+			}),
+			Runnable(func(context.Context) error {
+				completed = true
+
+				return nil
+			}),
+		),
+	)
+	is.Empty(t, err)
+
+	err = exec.Exec(context.Background())
+	is.True(t, errors.Is(err, context.DeadlineExceeded))
+	is.True(t, completed)
+	is.Equal(t, 1, m.errors)
+}
+
+func TestExecutable_ExecRetry(t *testing.T) {
+	errTransient := errors.New("transient error")
+
+	for _, testcase := range []struct {
+		name      string
+		failures  int
+		attempts  int
+		wantRuns  int
+		wantErr   error
+		runErrors int
+	}{
+		{
+			name:      "SucceedsOnRetry",
+			failures:  2,
+			attempts:  3,
+			wantRuns:  3,
+			runErrors: 2,
+		},
+		{
+			name:      "ExhaustsAttempts",
+			failures:  5,
+			attempts:  3,
+			wantRuns:  3,
+			wantErr:   errTransient,
+			runErrors: 3,
+		},
+		{
+			name:      "NoRetries",
+			failures:  1,
+			attempts:  1,
+			wantRuns:  1,
+			wantErr:   errTransient,
+			runErrors: 1,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var (
+				runs     int
+				backoffs []int
+			)
+
+			m := &errCounter{Metrics: metrics.NoOp()}
+
+			exec, err := New(testcase.name,
+				WithScheduler(nowScheduler{}),
+				WithMetrics(m),
+				WithRetry(testcase.attempts, func(attempt int) time.Duration {
+					backoffs = append(backoffs, attempt)
+
+					return time.Millisecond
+				}),
+				WithRunners(Runnable(func(context.Context) error {
+					runs++
+
+					if runs <= testcase.failures {
+						return errTransient
+					}
+
+					return nil
+				})),
+			)
+			is.Empty(t, err)
+
+			err = exec.Exec(context.Background())
+			is.True(t, errors.Is(err, testcase.wantErr))
+			is.Equal(t, testcase.wantRuns, runs)
+			is.Equal(t, testcase.runErrors, m.runErrors)
+			is.Equal(t, testcase.attempts-1, len(backoffs))
+		})
+	}
+}
+
+func TestExecutable_ExecRetryCancelled(t *testing.T) {
+	errTransient := errors.New("transient error")
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	exec, err := New("cancelled",
+		WithScheduler(nowScheduler{}),
+		WithRetry(3, func(int) time.Duration {
+			cancel()
+
+			return time.Hour
+		}),
+		WithRunners(Runnable(func(context.Context) error {
+			return errTransient
+		})),
+	)
+	is.Empty(t, err)
+
+	err = exec.Exec(ctx)
+	is.True(t, errors.Is(err, errTransient))
+	is.True(t, errors.Is(err, context.Canceled))
+}
+
+func TestExecutable_ExecSkipIfRunning(t *testing.T) {
+	var runs atomic.Int32
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	m := &errCounter{Metrics: metrics.NoOp()}
+
+	exec, err := New("skip",
+		WithScheduler(nowScheduler{}),
+		WithSkipIfRunning(),
+		WithMetrics(m),
+		WithRunners(Runnable(func(context.Context) error {
+			runs.Add(1)
+			close(started)
+			<-release
+
+			return nil
+		})),
+	)
+	is.Empty(t, err)
+
+	errCh := make(chan error)
+
+	go func() {
+		errCh <- exec.Exec(context.Background())
+	}()
+
+	<-started
+
+	// overlapping triggers, including concurrent ones, are skipped while the first run is in progress
+	is.Empty(t, Multi(context.Background(), exec, exec))
+	is.Equal(t, int32(2), m.skipped.Load())
+
+	close(release)
+	is.Empty(t, <-errCh)
+	is.Equal(t, int32(1), runs.Load())
+}
+
+func TestExecutable_ExecSameOccurrence(t *testing.T) {
+	var runs atomic.Int32
+
+	exec, err := New("same-occurrence",
+		WithScheduler(tickScheduler{every: 100 * time.Millisecond}),
+		WithRunners(Runnable(func(context.Context) error {
+			runs.Add(1)
+
+			return nil
+		})),
+	)
+	is.Empty(t, err)
+
+	// concurrent Exec calls waiting for the same occurrence only run the task once
+	is.Empty(t, Multi(context.Background(), exec, exec, exec))
+	is.Equal(t, int32(1), runs.Load())
+
+	// the following occurrence runs as usual
+	is.Empty(t, exec.Exec(context.Background()))
+	is.Equal(t, int32(2), runs.Load())
+}
+
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time { return time.Time(c) }
+
+func TestExecutable_WithClock(t *testing.T) {
+	now := time.Date(2024, time.March, 10, 9, 59, 59, 900_000_000, time.UTC)
+
+	var runnerTime time.Time
+
+	exec, err := New("clock",
+		WithSchedule("0 0 10 * * *"),
+		WithLocation(time.UTC),
+		WithClock(fixedClock(now)),
+		WithRunners(Runnable(func(ctx context.Context) error {
+			runnerTime, _ = ScheduledTime(ctx)
+
+			return nil
+		})),
+	)
+	is.Empty(t, err)
+
+	t.Run("Next", func(t *testing.T) {
+		is.Equal(t, time.Date(2024, time.March, 10, 10, 0, 0, 0, time.UTC), exec.Next(context.Background()))
+	})
+
+	t.Run("RunNow", func(t *testing.T) {
+		is.Empty(t, exec.RunNow(context.Background()))
+		is.Equal(t, now, runnerTime)
+	})
+}
+
+func TestExecutable_RunNow(t *testing.T) {
+	testErr := errors.New("test error")
+
+	t.Run("IgnoresSchedule", func(t *testing.T) {
+		var (
+			attempts   atomic.Int32
+			runnerTime time.Time
+		)
+
+		exec, err := New("run-now",
+			// the next occurrence is months away, at best
+			WithSchedule("0 0 1 1 *"),
+			WithRetry(2, nil),
+			WithRunners(Runnable(func(ctx context.Context) error {
+				runnerTime, _ = ScheduledTime(ctx)
+
+				if attempts.Add(1) == 1 {
+					return testErr
+				}
+
+				return nil
+			})),
+		)
+		is.Empty(t, err)
+
+		before := time.Now()
+		is.Empty(t, exec.RunNow(context.Background()))
+
+		is.Equal(t, int32(2), attempts.Load())
+		is.True(t, !runnerTime.Before(before))
+		is.True(t, time.Since(before) < time.Second)
+	})
+
+	t.Run("ReturnsErrors", func(t *testing.T) {
+		exec, err := New("run-now",
+			WithSchedule("0 0 1 1 *"),
+			WithRunners(Runnable(func(context.Context) error { return testErr })),
+		)
+		is.Empty(t, err)
+
+		is.True(t, errors.Is(exec.RunNow(context.Background()), testErr))
+	})
+
+	t.Run("SkipIfRunning", func(t *testing.T) {
+		var runs atomic.Int32
+
+		started := make(chan struct{})
+		release := make(chan struct{})
+		m := &errCounter{Metrics: metrics.NoOp()}
+
+		exec, err := New("run-now",
+			WithScheduler(nowScheduler{}),
+			WithSkipIfRunning(),
+			WithMetrics(m),
+			WithRunners(Runnable(func(context.Context) error {
+				runs.Add(1)
+				close(started)
+				<-release
+
+				return nil
+			})),
+		)
+		is.Empty(t, err)
+
+		errCh := make(chan error)
+
+		go func() {
+			errCh <- exec.RunNow(context.Background())
+		}()
+
+		<-started
+
+		// both scheduled and out-of-band runs are skipped while the first run is in progress
+		is.Empty(t, exec.RunNow(context.Background()))
+		is.Empty(t, exec.Exec(context.Background()))
+		is.Equal(t, int32(2), m.skipped.Load())
+
+		close(release)
+		is.Empty(t, <-errCh)
+		is.Equal(t, int32(1), runs.Load())
+	})
+}
+
+func TestExecutable_ParallelRunners(t *testing.T) {
+	t.Run("RunsConcurrently", func(t *testing.T) {
+		// each runner waits for the other one to start, which is only possible if they run concurrently
+		var wg sync.WaitGroup
+
+		wg.Add(2)
+
+		runner := Runnable(func(ctx context.Context) error {
+			wg.Done()
+			wg.Wait()
+
+			return nil
+		})
+
+		exec, err := New("parallel",
+			WithSchedule("0 0 1 1 *"),
+			WithParallelRunners(),
+			WithRunners(runner, runner),
+		)
+		is.Empty(t, err)
+
+		is.Empty(t, exec.RunNow(context.Background()))
+	})
+
+	t.Run("Sequential", func(t *testing.T) {
+		var order []int
+
+		exec, err := New("sequential",
+			WithSchedule("0 0 1 1 *"),
+			WithRunners(
+				Runnable(func(context.Context) error {
+					time.Sleep(10 * time.Millisecond)
+					order = append(order, 1)
+
+					return nil
+				}),
+				Runnable(func(context.Context) error {
+					order = append(order, 2)
+
+					return nil
+				}),
+			),
+		)
+		is.Empty(t, err)
+
+		is.Empty(t, exec.RunNow(context.Background()))
+		is.EqualElements(t, []int{1, 2}, order)
+	})
+
+	t.Run("JoinsErrorsInOrder", func(t *testing.T) {
+		slowErr := errors.New("slow error")
+		fastErr := errors.New("fast error")
+
+		exec, err := New("parallel",
+			WithSchedule("0 0 1 1 *"),
+			WithParallelRunners(),
+			WithRunners(
+				Runnable(func(context.Context) error {
+					time.Sleep(10 * time.Millisecond)
+
+					return slowErr
+				}),
+				Runnable(func(context.Context) error { return nil }),
+				Runnable(func(context.Context) error { return fastErr }),
+			),
+		)
+		is.Empty(t, err)
+
+		err = exec.RunNow(context.Background())
+		is.True(t, errors.Is(err, slowErr))
+		is.True(t, errors.Is(err, fastErr))
+		is.Equal(t, errors.Join(slowErr, fastErr).Error(), err.Error())
+	})
+}
+
+func TestExecutable_ExecNamedRunners(t *testing.T) {
+	errFailed := errors.New("failed")
+
+	var order []string
+
+	m := &errCounter{Metrics: metrics.NoOp()}
+
+	exec, err := New("named",
+		WithScheduler(nowScheduler{}),
+		WithMetrics(m),
+		WithNamedRunners(map[string]Runner{
+			"b": Runnable(func(context.Context) error {
+				order = append(order, "b")
+
+				return errFailed
+			}),
+			"a": Runnable(func(context.Context) error {
+				order = append(order, "a")
+
+				return errFailed
+			}),
+			"c": Runnable(func(context.Context) error {
+				order = append(order, "c")
+
+				return nil
+			}),
+		}),
+		WithRunners(Runnable(func(context.Context) error {
+			return errFailed
+		})),
+	)
+	is.Empty(t, err)
+
+	err = exec.Exec(context.Background())
+	is.True(t, errors.Is(err, errFailed))
+
+	var runnerErr *RunnerError
+
+	is.True(t, errors.As(err, &runnerErr))
+	is.Equal(t, "a", runnerErr.Name)
+	is.Equal(t, "runner a: failed\nrunner b: failed\nfailed", err.Error())
+
+	is.EqualElements(t, []string{"a", "b", "c"}, order)
+	is.Equal(t, 3, m.errors)
+	is.EqualElements(t, []string{"a", "b", ""}, m.runners)
+}
+
+func TestExecutable_ExecHooks(t *testing.T) {
+	errFailed := errors.New("failed")
+
+	var (
+		events    []string
+		scheduled time.Time
+		afterErr  error
+		afterDur  time.Duration
+	)
+
+	exec, err := New("hooks",
+		WithScheduler(nowScheduler{}),
+		WithBeforeExec(func(_ context.Context, id string, at time.Time) {
+			events = append(events, "before:"+id)
+			scheduled = at
+		}),
+		WithAfterExec(func(_ context.Context, id string, err error, dur time.Duration) {
+			events = append(events, "after:"+id)
+			afterErr = err
+			afterDur = dur
+		}),
+		WithRunners(Runnable(func(context.Context) error {
+			events = append(events, "run")
+
+			return errFailed
+		})),
+	)
+	is.Empty(t, err)
+
+	start := time.Now()
+	err = exec.Exec(context.Background())
+
+	is.True(t, errors.Is(err, errFailed))
+	is.EqualElements(t, []string{"before:hooks", "run", "after:hooks"}, events)
+	is.False(t, scheduled.Before(start))
+	is.True(t, errors.Is(afterErr, errFailed))
+	is.True(t, afterDur > 0)
+}
+
+func TestExecutable_ExecScheduledTime(t *testing.T) {
+	var (
+		hookTime   time.Time
+		runnerTime time.Time
+		ok         bool
+	)
+
+	exec, err := New("scheduled-time",
+		WithScheduler(nowScheduler{}),
+		WithTriggerBuffer(0),
+		WithBeforeExec(func(_ context.Context, _ string, at time.Time) {
+			hookTime = at
+		}),
+		WithRunners(Runnable(func(ctx context.Context) error {
+			runnerTime, ok = ScheduledTime(ctx)
+
+			return nil
+		})),
+	)
+	is.Empty(t, err)
+	is.Empty(t, exec.Exec(context.Background()))
+
+	is.True(t, ok)
+	is.False(t, runnerTime.IsZero())
+	is.True(t, runnerTime.Equal(hookTime))
+
+	_, ok = ScheduledTime(context.Background())
+	is.False(t, ok)
+}
+
+func TestExecutable_ExecRunID(t *testing.T) {
+	var ids []string
+
+	runner := Runnable(func(ctx context.Context) error {
+		ids = append(ids, RunID(ctx))
+
+		return nil
+	})
+
+	buf := &bytes.Buffer{}
+
+	exec, err := New("run-id",
+		WithScheduler(nowScheduler{}),
+		WithTriggerBuffer(0),
+		WithRunners(runner, runner),
+		WithLogHandler(slog.NewJSONHandler(buf, nil)),
+	)
+	is.Empty(t, err)
+
+	is.Empty(t, exec.Exec(context.Background()))
+	is.Empty(t, exec.Exec(context.Background()))
+
+	is.Equal(t, 4, len(ids))
+	is.Equal(t, 36, len(ids[0]))
+	// runners in the same Exec call share the run ID, which is unique to each call
+	is.Equal(t, ids[0], ids[1])
+	is.Equal(t, ids[2], ids[3])
+	is.True(t, ids[0] != ids[2])
+	is.True(t, strings.Contains(buf.String(), `"run_id":"`+ids[0]+`"`))
+
+	is.Equal(t, "", RunID(context.Background()))
+}
+
+func TestExecutable_Jitter(t *testing.T) {
+	const (
+		seed   = 42
+		rounds = 100
+	)
+
+	next := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	span := trace.SpanFromContext(context.Background())
+
+	newExecutable := func(t *testing.T, cron string, maxJitter time.Duration) *Executable {
+		t.Helper()
+
+		exec, err := New("jitter",
+			WithSchedule(cron),
+			WithLocation(time.UTC),
+			WithJitter(maxJitter),
+			WithJitterSource(rand.NewSource(seed)),
+			WithRunners(Runnable(func(context.Context) error { return nil })),
+		)
+		is.Empty(t, err)
+
+		e, ok := exec.(*Executable)
+		is.True(t, ok)
+
+		return e
+	}
+
+	for _, testcase := range []struct {
+		name  string
+		cron  string
+		max   time.Duration
+		limit time.Duration
+	}{
+		{
+			name:  "WithinMaxJitter",
+			cron:  "0 * * * *",
+			max:   time.Second,
+			limit: time.Second,
+		},
+		{
+			name:  "CappedByFollowingOccurrence",
+			cron:  "* * * * * *",
+			max:   time.Minute,
+			limit: time.Second,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			e := newExecutable(t, testcase.cron, testcase.max)
+			reproduced := newExecutable(t, testcase.cron, testcase.max)
+
+			for i := 0; i < rounds; i++ {
+				jitter := e.jitter(context.Background(), span, next)
+
+				is.True(t, jitter >= 0)
+				is.True(t, jitter < testcase.limit)
+				is.Equal(t, jitter, reproduced.jitter(context.Background(), span, next))
+			}
+		})
+	}
+
+	t.Run("Disabled", func(t *testing.T) {
+		e := newExecutable(t, "* * * * * *", 0)
+
+		is.Equal(t, time.Duration(0), e.jitter(context.Background(), span, next))
+	})
+}
+
+func TestExecutable_ExecDrift(t *testing.T) {
+	var started time.Time
+
+	m := &errCounter{Metrics: metrics.NoOp()}
+
+	exec, err := New("drift",
+		WithScheduler(nowScheduler{}),
+		WithMetrics(m),
+		WithBeforeExec(func(_ context.Context, _ string, scheduled time.Time) {
+			started = time.Now()
+
+			is.False(t, started.Before(scheduled))
+		}),
+		WithRunners(Runnable(func(context.Context) error { return nil })),
+	)
+	is.Empty(t, err)
+
+	start := time.Now()
+
+	is.Empty(t, exec.Exec(context.Background()))
+	is.Equal(t, 1, len(m.drifts))
+	is.True(t, m.drifts[0] > 0)
+	is.True(t, m.drifts[0] <= started.Sub(start))
+}
+
+func TestWithTriggerBuffer(t *testing.T) {
+	for _, testcase := range []struct {
+		name  string
+		opts  []cfg.Option[*Config]
+		wants time.Duration
+	}{
+		{
+			name:  "Default",
+			wants: bufferPeriod,
+		},
+		{
+			name:  "Negative",
+			opts:  []cfg.Option[*Config]{WithTriggerBuffer(-time.Second)},
+			wants: bufferPeriod,
+		},
+		{
+			name:  "Disabled",
+			opts:  []cfg.Option[*Config]{WithTriggerBuffer(0)},
+			wants: 0,
+		},
+		{
+			name:  "Custom",
+			opts:  []cfg.Option[*Config]{WithTriggerBuffer(250 * time.Millisecond)},
+			wants: 250 * time.Millisecond,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			exec, err := New(testcase.name, append(testcase.opts,
+				WithScheduler(nowScheduler{}),
+				WithRunners(Runnable(func(context.Context) error { return nil })),
+			)...)
+			is.Empty(t, err)
+
+			e, ok := exec.(*Executable)
+			is.True(t, ok)
+			is.Equal(t, testcase.wants, e.triggerBuffer)
+		})
+	}
+}
+
+func TestWithSecondsDisabled(t *testing.T) {
+	exec, err := New("minutely",
+		WithSchedule("* * * * * *"),
+		WithSecondsDisabled(),
+		WithRunners(Runnable(func(context.Context) error { return nil })),
+	)
+	is.Empty(t, err)
+
+	before := time.Now()
+	next := exec.Next(context.Background())
+
+	is.Equal(t, 0, next.Second())
+	is.True(t, next.After(before))
+	is.True(t, next.Sub(before) <= time.Minute)
+}
+
+func TestExecutable_SpanAttributes(t *testing.T) {
+	testErr := errors.New("test error")
+
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+	exec, err := New("partial-failure",
+		WithSchedule("0 0 1 1 *"),
+		WithRunners(
+			Runnable(func(context.Context) error { return nil }),
+			Runnable(func(context.Context) error { return testErr }),
+			Runnable(func(context.Context) error { return nil }),
+		),
+		WithTrace(tracer),
+	)
+	is.Empty(t, err)
+
+	is.True(t, errors.Is(exec.RunNow(context.Background()), testErr))
+
+	spans := recorder.Ended()
+	is.Equal(t, 1, len(spans))
+
+	attrs := make(map[attribute.Key]attribute.Value, len(spans[0].Attributes()))
+
+	for _, attr := range spans[0].Attributes() {
+		attrs[attr.Key] = attr.Value
+	}
+
+	is.Equal(t, int64(3), attrs["num_runners"].AsInt64())
+	is.Equal(t, int64(1), attrs["num_failed_runners"].AsInt64())
+
+	dur, err := time.ParseDuration(attrs["duration"].AsString())
+	is.Empty(t, err)
+	is.True(t, dur > 0)
+}
+
+func TestWithResultHandler(t *testing.T) {
+	testErr := errors.New("test error")
+
+	exec, err := New("results",
+		// the next occurrence is months away, at best
+		WithSchedule("0 0 1 1 *"),
+		WithRunners(Runnable(func(context.Context) error {
+			time.Sleep(10 * time.Millisecond)
+
+			return testErr
+		})),
+	)
+	is.Empty(t, err)
+
+	var (
+		results []ExecResult
+		runID   string
+	)
+
+	ctx := WithResultHandler(context.Background(), func(result ExecResult) {
+		results = append(results, result)
+	})
+
+	before := time.Now()
+	err = exec.(*Executable).RunNow(ctx)
+	is.True(t, errors.Is(err, testErr))
+	is.Equal(t, 1, len(results))
+
+	runID = results[0].RunID
+
+	is.Equal(t, "results", results[0].ID)
+	is.True(t, runID != "")
+	is.True(t, errors.Is(results[0].Err, testErr))
+	is.True(t, !results[0].Start.Before(before))
+	is.True(t, !results[0].Scheduled.Before(before))
+	is.True(t, results[0].Duration >= 10*time.Millisecond)
+
+	// no handler in the context.Context
+	is.True(t, errors.Is(exec.(*Executable).RunNow(context.Background()), testErr))
+	is.Equal(t, 1, len(results))
+}
+
+func TestWithSlots(t *testing.T) {
+	t.Run("NoSlots", func(t *testing.T) {
+		release, err := AcquireSlot(context.Background())
+		is.Empty(t, err)
+
+		release()
+	})
+
+	t.Run("Cancelled", func(t *testing.T) {
+		slots := make(chan struct{}, 1)
+		slots <- struct{}{}
+
+		ctx, cancel := context.WithCancel(WithSlots(context.Background(), slots))
+		cancel()
+
+		_, err := AcquireSlot(ctx)
+		is.True(t, errors.Is(err, context.Canceled))
+	})
+
+	t.Run("Exec", func(t *testing.T) {
+		var (
+			runs  atomic.Int32
+			slots = make(chan struct{}, 1)
+			errCh = make(chan error)
+		)
+
+		exec, err := New("slots",
+			WithScheduler(tickScheduler{every: 50 * time.Millisecond}),
+			WithRunners(Runnable(func(context.Context) error {
+				runs.Add(1)
+
+				// the slot is held while the runners are called
+				is.Equal(t, 1, len(slots))
+
+				return nil
+			})),
+		)
+		is.Empty(t, err)
+
+		// all slots are taken, so the due execution waits for one
+		slots <- struct{}{}
+
+		go func() {
+			errCh <- exec.Exec(WithSlots(context.Background(), slots))
+		}()
+
+		time.Sleep(150 * time.Millisecond)
+		is.Equal(t, int32(0), runs.Load())
+
+		<-slots
+
+		is.Empty(t, <-errCh)
+		is.Equal(t, int32(1), runs.Load())
+		is.Equal(t, 0, len(slots))
+	})
+}
+
+func TestExecutable_SetEnabled(t *testing.T) {
+	var runs atomic.Int32
+
+	m := &errCounter{Metrics: metrics.NoOp()}
+
+	exec, err := New("pausable",
+		WithScheduler(nowScheduler{}),
+		WithMetrics(m),
+		WithRunners(Runnable(func(context.Context) error {
+			runs.Add(1)
+
+			return nil
+		})),
+	)
+	is.Empty(t, err)
+
+	executable := exec.(*Executable)
+	is.True(t, executable.Enabled())
+
+	executable.SetEnabled(false)
+	is.False(t, executable.Enabled())
+
+	// a disabled executor skips its executions, while still reporting its schedule
+	is.Empty(t, exec.Exec(context.Background()))
+	is.Equal(t, int32(0), runs.Load())
+	is.Equal(t, int32(1), m.skipped.Load())
+	is.False(t, exec.Next(context.Background()).IsZero())
+
+	// explicitly requested runs are not skipped
+	is.Empty(t, exec.RunNow(context.Background()))
+	is.Equal(t, int32(1), runs.Load())
+
+	executable.SetEnabled(true)
+
+	is.Empty(t, exec.Exec(context.Background()))
+	is.Equal(t, int32(2), runs.Load())
+	is.Equal(t, int32(1), m.skipped.Load())
+}
+
+// tickScheduler triggers on every multiple of its interval.
+type tickScheduler struct {
+	every time.Duration
+}
+
+func (s tickScheduler) Next(_ context.Context, t time.Time) time.Time {
+	return t.Truncate(s.every).Add(s.every)
+}
+func (s tickScheduler) Prev(_ context.Context, t time.Time) time.Time { return t.Truncate(s.every) }
+func (s tickScheduler) Until(ctx context.Context, t time.Time) time.Duration {
+	return s.Next(ctx, t).Sub(t)
+}
+
+func TestWithMissedRunPolicy(t *testing.T) {
+	const every = 200 * time.Millisecond
+
+	for _, testcase := range []struct {
+		name   string
+		policy MissedRunPolicy
+		// wants lists the occurrences run by the second Exec call, as offsets from the first call's occurrence
+		wants []time.Duration
+	}{
+		{
+			name:   "SkipAll",
+			policy: SkipAll,
+			wants:  []time.Duration{4 * every},
+		},
+		{
+			name:   "RunOnce",
+			policy: RunOnce,
+			wants:  []time.Duration{3 * every},
+		},
+		{
+			name:   "RunAll/Limited",
+			policy: RunAll(2),
+			wants:  []time.Duration{2 * every, 3 * every},
+		},
+		{
+			name:   "RunAll/Unlimited",
+			policy: RunAll(0),
+			wants:  []time.Duration{every, 2 * every, 3 * every},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var scheduled []time.Time
+
+			exec, err := New("catch-up",
+				WithScheduler(tickScheduler{every: every}),
+				WithTriggerBuffer(0),
+				WithMissedRunPolicy(testcase.policy),
+				WithRunners(Runnable(func(ctx context.Context) error {
+					at, _ := ScheduledTime(ctx)
+					scheduled = append(scheduled, at)
+
+					return nil
+				})),
+			)
+			is.Empty(t, err)
+
+			is.Empty(t, exec.Exec(context.Background()))
+			is.Equal(t, 1, len(scheduled))
+
+			first := scheduled[0]
+
+			// simulate a pause that misses three occurrences
+			time.Sleep(time.Until(first.Add(3*every + every/2)))
+
+			is.Empty(t, exec.Exec(context.Background()))
+			is.Equal(t, len(testcase.wants)+1, len(scheduled))
+
+			for i := range testcase.wants {
+				is.Equal(t, first.Add(testcase.wants[i]), scheduled[i+1])
+			}
+		})
+	}
+
+	t.Run("RunAll/Interval", func(t *testing.T) {
+		var scheduled []time.Time
+
+		exec, err := New("catch-up",
+			WithSchedule("@every 1s"),
+			WithTriggerBuffer(0),
+			WithMissedRunPolicy(RunAll(0)),
+			WithRunners(Runnable(func(ctx context.Context) error {
+				at, _ := ScheduledTime(ctx)
+				scheduled = append(scheduled, at)
+
+				return nil
+			})),
+		)
+		is.Empty(t, err)
+
+		is.Empty(t, exec.Exec(context.Background()))
+		is.Equal(t, 1, len(scheduled))
+
+		first := scheduled[0]
+
+		// simulate a pause that misses two occurrences, which are anchored on the first one
+		time.Sleep(time.Until(first.Add(2*time.Second + 500*time.Millisecond)))
+
+		is.Empty(t, exec.Exec(context.Background()))
+		is.Equal(t, 3, len(scheduled))
+		is.Equal(t, first.Add(time.Second), scheduled[1])
+		is.Equal(t, first.Add(2*time.Second), scheduled[2])
+	})
+
+	t.Run("RunAll/SkippedForOverlap", func(t *testing.T) {
+		var (
+			mu        sync.Mutex
+			scheduled []time.Time
+			started   = make(chan struct{})
+			errCh     = make(chan error)
+		)
+
+		exec, err := New("catch-up",
+			WithScheduler(tickScheduler{every: every}),
+			WithTriggerBuffer(0),
+			WithSkipIfRunning(),
+			WithMissedRunPolicy(RunAll(0)),
+			WithRunners(Runnable(func(ctx context.Context) error {
+				at, _ := ScheduledTime(ctx)
+
+				mu.Lock()
+				scheduled = append(scheduled, at)
+				first := len(scheduled) == 1
+				mu.Unlock()
+
+				// the first run outlasts the following occurrence
+				if first {
+					close(started)
+					time.Sleep(every + every/2)
+				}
+
+				return nil
+			})),
+		)
+		is.Empty(t, err)
+
+		go func() {
+			errCh <- exec.Exec(context.Background())
+		}()
+
+		<-started
+
+		// the following occurrence is skipped, as the first run is still in progress
+		is.Empty(t, exec.Exec(context.Background()))
+		is.Empty(t, <-errCh)
+
+		// the skipped occurrence is caught up on, as it did not run
+		is.Empty(t, exec.Exec(context.Background()))
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		is.Equal(t, 2, len(scheduled))
+		is.Equal(t, scheduled[0].Add(every), scheduled[1])
+	})
+
+	t.Run("RunAll/Capped", func(t *testing.T) {
+		is.Equal(t, maxMissedRuns, RunAll(maxMissedRuns*10).limit)
+		is.Equal(t, 5, RunAll(5).limit)
+	})
+}
+
+func TestExecutable_Stats(t *testing.T) {
+	testErr := errors.New("test error")
+
+	var fail atomic.Bool
+
+	exec, err := New("stats",
+		WithScheduler(nowScheduler{}),
+		WithRunners(Runnable(func(context.Context) error {
+			if fail.Load() {
+				return testErr
+			}
+
+			return nil
+		})),
+	)
+	is.Empty(t, err)
+
+	stats, ok := exec.(StatsExecutor)
+	is.True(t, ok)
+
+	lastRun, lastErr := stats.LastRun()
+	is.True(t, lastRun.IsZero())
+	is.Empty(t, lastErr)
+	is.Equal(t, uint64(0), stats.RunCount())
+
+	before := time.Now()
+
+	is.Empty(t, exec.Exec(context.Background()))
+
+	lastRun, lastErr = stats.LastRun()
+	is.True(t, !lastRun.Before(before))
+	is.Empty(t, lastErr)
+	is.Equal(t, uint64(1), stats.RunCount())
+
+	fail.Store(true)
+
+	is.True(t, errors.Is(exec.RunNow(context.Background()), testErr))
+
+	_, lastErr = stats.LastRun()
+	is.True(t, errors.Is(lastErr, testErr))
+	is.Equal(t, uint64(2), stats.RunCount())
+
+	// skipped executions are not runs
+	stats.(*Executable).SetEnabled(false)
+
+	is.Empty(t, exec.Exec(context.Background()))
+	is.Equal(t, uint64(2), stats.RunCount())
+}
+
+func TestExecutable_Expr(t *testing.T) {
+	runner := Runnable(func(context.Context) error { return nil })
+
+	cron, err := schedule.New(schedule.WithSchedule("0 9 * * *"))
+	is.Empty(t, err)
+
+	for _, testcase := range []struct {
+		name string
+		opts []cfg.Option[*Config]
+		expr string
+	}{
+		{
+			name: "WithSchedule",
+			opts: []cfg.Option[*Config]{WithSchedule("@every 5m")},
+			expr: "@every 5m",
+		},
+		{
+			name: "WithScheduler/CronSchedule",
+			opts: []cfg.Option[*Config]{WithScheduler(cron)},
+			expr: "0 9 * * *",
+		},
+		{
+			name: "WithScheduler/Other",
+			opts: []cfg.Option[*Config]{WithScheduler(nowScheduler{})},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			exec, err := New("expr", append(testcase.opts, WithRunners(runner))...)
+			is.Empty(t, err)
+
+			e, ok := exec.(*Executable)
+			is.True(t, ok)
+			is.Equal(t, testcase.expr, e.Expr())
+		})
+	}
+}
+
+func TestWithScheduleOffset(t *testing.T) {
+	runner := Runnable(func(context.Context) error { return nil })
+
+	base, err := New("base",
+		WithSchedule("0 0 1 1 *"),
+		WithLocation(time.UTC),
+		WithRunners(runner),
+	)
+	is.Empty(t, err)
+
+	for _, testcase := range []struct {
+		name   string
+		offset time.Duration
+	}{
+		{name: "Positive", offset: 5 * time.Minute},
+		{name: "Negative", offset: -5 * time.Minute},
+		{name: "Zero"},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			exec, err := New("offset",
+				WithSchedule("0 0 1 1 *"),
+				WithLocation(time.UTC),
+				WithScheduleOffset(testcase.offset),
+				WithRunners(runner),
+			)
+			is.Empty(t, err)
+
+			is.Equal(t, base.Next(context.Background()).Add(testcase.offset), exec.Next(context.Background()))
+			is.Equal(t, "0 0 1 1 *", exec.(*Executable).Expr())
+		})
+	}
+}
+
+func TestWithAlignment(t *testing.T) {
+	const interval = 5 * time.Minute
+
+	runner := Runnable(func(context.Context) error { return nil })
+
+	for _, testcase := range []struct {
+		name    string
+		aligned bool
+	}{
+		{name: "Aligned", aligned: true},
+		{name: "FromStart"},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			exec, err := New("interval",
+				WithSchedule("@every 5m"),
+				WithLocation(time.UTC),
+				WithAlignment(testcase.aligned),
+				WithRunners(runner),
+			)
+			is.Empty(t, err)
+
+			sched, ok := exec.(*Executable).cron.(*schedule.IntervalSchedule)
+			is.True(t, ok)
+			is.Equal(t, testcase.aligned, sched.Aligned)
+
+			before := time.Now()
+			next := exec.Next(context.Background())
+			after := time.Now()
+
+			if testcase.aligned {
+				is.True(t, next.Equal(next.Truncate(interval)))
+				is.True(t, next.After(before))
+				is.True(t, !next.After(after.Add(interval)))
+
+				return
+			}
+
+			is.True(t, !next.Before(before.Add(interval)))
+			is.True(t, !next.After(after.Add(interval)))
+		})
+	}
+}
+
+func TestWithDryRun(t *testing.T) {
+	var (
+		runs    atomic.Int32
+		buf     = &bytes.Buffer{}
+		results []ExecResult
+	)
+
+	m := &errCounter{Metrics: metrics.NoOp()}
+	recorder := tracetest.NewSpanRecorder()
+
+	exec, err := New("dry-run",
+		WithScheduler(nowScheduler{}),
+		WithDryRun(),
+		WithMetrics(m),
+		WithLogHandler(slog.NewJSONHandler(buf, nil)),
+		WithTrace(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")),
+		WithRunners(Runnable(func(context.Context) error {
+			runs.Add(1)
+
+			return errors.New("should not run")
+		})),
+	)
+	is.Empty(t, err)
+
+	ctx := WithResultHandler(context.Background(), func(result ExecResult) {
+		results = append(results, result)
+	})
+
+	// both scheduled and out-of-band runs go through their usual steps, without calling the runners
+	is.Empty(t, exec.Exec(ctx))
+	is.Empty(t, exec.RunNow(ctx))
+
+	is.Equal(t, int32(0), runs.Load())
+	is.Equal(t, int32(2), m.skipped.Load())
+	is.Equal(t, 0, len(m.runners))
+	is.Equal(t, 2, strings.Count(buf.String(), "dry-run would execute"))
+	is.Equal(t, uint64(2), exec.(StatsExecutor).RunCount())
+
+	is.Equal(t, 2, len(results))
+
+	for i := range results {
+		is.True(t, results[i].DryRun)
+		is.Empty(t, results[i].Err)
+	}
+
+	spans := recorder.Ended()
+	is.Equal(t, 2, len(spans))
+
+	for i := range spans {
+		var dryRun bool
+
+		for _, attr := range spans[i].Attributes() {
+			if attr.Key == "dry_run" {
+				dryRun = attr.Value.AsBool()
+			}
+		}
+
+		is.True(t, dryRun)
+	}
+}
+
+func TestWithWeekStart(t *testing.T) {
+	runner := Runnable(func(context.Context) error { return nil })
+
+	sunday, err := New("sunday",
+		WithSchedule("0 9 * * 1-5"),
+		WithLocation(time.UTC),
+		WithRunners(runner),
+	)
+	is.Empty(t, err)
+
+	monday, err := New("monday",
+		WithSchedule("0 9 * * 0-4"),
+		WithLocation(time.UTC),
+		WithWeekStart(time.Monday),
+		WithRunners(runner),
+	)
+	is.Empty(t, err)
+
+	is.Equal(t, sunday.Next(context.Background()), monday.Next(context.Background()))
+}
@@ -0,0 +1,156 @@
+package executor
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/zalgonoise/cfg"
+)
+
+// HTTPConfig configures an HTTPRunner's request body, headers and HTTP client.
+type HTTPConfig struct {
+	body    []byte
+	headers http.Header
+	client  *http.Client
+}
+
+// WithHTTPBody sets the body sent in each of an HTTPRunner's requests.
+//
+// This call returns a cfg.NoOp cfg.Option if the input body is empty.
+func WithHTTPBody(body []byte) cfg.Option[HTTPConfig] {
+	if len(body) == 0 {
+		return cfg.NoOp[HTTPConfig]{}
+	}
+
+	body = bytes.Clone(body)
+
+	return cfg.Register(func(config HTTPConfig) HTTPConfig {
+		config.body = body
+
+		return config
+	})
+}
+
+// WithHTTPHeaders adds the input headers to each of an HTTPRunner's requests. Multiple calls add up, where the values
+// of a header set in the latest call replace the previous ones.
+//
+// This call returns a cfg.NoOp cfg.Option if the input headers are empty.
+func WithHTTPHeaders(headers http.Header) cfg.Option[HTTPConfig] {
+	if len(headers) == 0 {
+		return cfg.NoOp[HTTPConfig]{}
+	}
+
+	headers = headers.Clone()
+
+	return cfg.Register(func(config HTTPConfig) HTTPConfig {
+		merged := config.headers.Clone()
+		if merged == nil {
+			merged = make(http.Header, len(headers))
+		}
+
+		for key, values := range headers {
+			merged[http.CanonicalHeaderKey(key)] = values
+		}
+
+		config.headers = merged
+
+		return config
+	})
+}
+
+// WithHTTPClient sets the http.Client issuing an HTTPRunner's requests, which is http.DefaultClient by default.
+//
+// This call returns a cfg.NoOp cfg.Option if the input http.Client is nil.
+func WithHTTPClient(client *http.Client) cfg.Option[HTTPConfig] {
+	if client == nil {
+		return cfg.NoOp[HTTPConfig]{}
+	}
+
+	return cfg.Register(func(config HTTPConfig) HTTPConfig {
+		config.client = client
+
+		return config
+	})
+}
+
+// HTTPRunner is a Runner that issues an HTTP request, like calling a webhook on a schedule.
+//
+// Each Run call issues the request with the Run call's context.Context, so its timeout is set with WithRunTimeout.
+// Any response without a 2xx status code results in an ErrFailedRequest error, which includes the status code and the
+// beginning of the response body.
+type HTTPRunner struct {
+	method string
+	url    string
+
+	config HTTPConfig
+}
+
+// NewHTTPRunner creates an HTTPRunner issuing a request with the input method and URL, configured with the input
+// cfg.Option(s), such as WithHTTPBody, WithHTTPHeaders and WithHTTPClient.
+//
+// An empty method defaults to GET. An error is returned if the method or URL are invalid.
+func NewHTTPRunner(method, url string, options ...cfg.Option[HTTPConfig]) (*HTTPRunner, error) {
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	// validating the request once, so that Run only fails on issuing it
+	if _, err := http.NewRequest(method, url, http.NoBody); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+	}
+
+	config := cfg.Set(HTTPConfig{}, options...)
+	if config.client == nil {
+		config.client = http.DefaultClient
+	}
+
+	return &HTTPRunner{
+		method: method,
+		url:    url,
+		config: config,
+	}, nil
+}
+
+// Run issues the HTTPRunner's request and reads its response, returning an error if the request fails or if the
+// response's status code is not 2xx.
+func (r *HTTPRunner) Run(ctx context.Context) error {
+	var body io.Reader = http.NoBody
+	if len(r.config.body) > 0 {
+		body = bytes.NewReader(r.config.body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, r.method, r.url, body)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+	}
+
+	for key, values := range r.config.headers {
+		req.Header[key] = values
+	}
+
+	res, err := r.config.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %s %s: %w", ErrFailedRequest, r.method, r.url, err)
+	}
+
+	defer res.Body.Close()
+
+	output := &limitedBuffer{limit: maxErrorOutput}
+
+	// draining the body allows reusing the connection
+	_, _ = io.Copy(output, res.Body)
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		if text := strings.TrimSpace(output.String()); text != "" {
+			return fmt.Errorf("%w: %s %s: status code %d: %s", ErrFailedRequest, r.method, r.url, res.StatusCode, text)
+		}
+
+		return fmt.Errorf("%w: %s %s: status code %d", ErrFailedRequest, r.method, r.url, res.StatusCode)
+	}
+
+	return nil
+}
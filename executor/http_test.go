@@ -0,0 +1,148 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zalgonoise/cfg"
+	"github.com/zalgonoise/x/is"
+)
+
+func TestHTTPRunner_Run(t *testing.T) {
+	type request struct {
+		method string
+		body   string
+		header string
+	}
+
+	requests := make(chan request, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests <- request{method: r.Method, body: string(body), header: r.Header.Get("X-Token")}
+
+		switch r.URL.Path {
+		case "/fail":
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte("something went wrong"))
+		case "/redirect":
+			w.WriteHeader(http.StatusNotModified)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	for _, testcase := range []struct {
+		name    string
+		method  string
+		path    string
+		options []cfg.Option[HTTPConfig]
+		wants   request
+		errMsg  string
+		err     error
+	}{
+		{
+			name:  "Success/DefaultMethod",
+			path:  "/ok",
+			wants: request{method: http.MethodGet},
+		},
+		{
+			name:   "Success/WithBodyAndHeaders",
+			method: http.MethodPost,
+			path:   "/ok",
+			options: []cfg.Option[HTTPConfig]{
+				WithHTTPBody([]byte(`{"ping":true}`)),
+				WithHTTPHeaders(http.Header{"X-Token": []string{"old"}}),
+				WithHTTPHeaders(http.Header{"x-token": []string{"secret"}}),
+				WithHTTPClient(server.Client()),
+			},
+			wants: request{method: http.MethodPost, body: `{"ping":true}`, header: "secret"},
+		},
+		{
+			name:   "Fail/ServerError",
+			method: http.MethodPut,
+			path:   "/fail",
+			wants:  request{method: http.MethodPut},
+			errMsg: "status code 500: something went wrong",
+			err:    ErrFailedRequest,
+		},
+		{
+			name:   "Fail/NotSuccessful",
+			path:   "/redirect",
+			wants:  request{method: http.MethodGet},
+			errMsg: "status code 304",
+			err:    ErrFailedRequest,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			runner, err := NewHTTPRunner(testcase.method, server.URL+testcase.path, testcase.options...)
+			is.Empty(t, err)
+
+			err = runner.Run(context.Background())
+			is.Equal(t, testcase.wants, <-requests)
+
+			if testcase.err == nil {
+				is.Empty(t, err)
+
+				return
+			}
+
+			is.True(t, errors.Is(err, testcase.err))
+			is.True(t, strings.Contains(err.Error(), testcase.errMsg))
+		})
+	}
+}
+
+func TestHTTPRunner_RunTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	runner, err := NewHTTPRunner(http.MethodGet, server.URL)
+	is.Empty(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err = runner.Run(ctx)
+	is.True(t, errors.Is(err, ErrFailedRequest))
+	is.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestNewHTTPRunner(t *testing.T) {
+	for _, testcase := range []struct {
+		name   string
+		method string
+		url    string
+		err    error
+	}{
+		{
+			name: "Success",
+			url:  "http://localhost:8080/hook",
+		},
+		{
+			name:   "Fail/InvalidMethod",
+			method: "BAD METHOD",
+			url:    "http://localhost:8080/hook",
+			err:    ErrInvalidRequest,
+		},
+		{
+			name: "Fail/InvalidURL",
+			url:  "http://local host:8080",
+			err:  ErrInvalidRequest,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			_, err := NewHTTPRunner(testcase.method, testcase.url)
+			is.True(t, errors.Is(err, testcase.err))
+		})
+	}
+}
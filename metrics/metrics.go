@@ -19,10 +19,15 @@ type Metrics interface {
 	IncSchedulerNextCalls()
 	IncSelectorSelectCalls()
 	IncSelectorSelectErrors()
+	ObserveRegisteredExecutors(n int)
+	ObserveSelectLatency(ctx context.Context, dur time.Duration)
 	IncExecutorExecCalls(id string)
-	IncExecutorExecErrors(id string)
+	IncExecutorExecErrors(id, runner string)
 	ObserveExecLatency(ctx context.Context, id string, dur time.Duration)
+	ObserveExecDrift(ctx context.Context, id string, drift time.Duration)
 	IncExecutorNextCalls(id string)
+	IncExecutorRunErrors(id string)
+	IncExecutorSkippedRuns(id, reason string)
 	IsUp(bool)
 
 	Shutdown(ctx context.Context) error
@@ -33,8 +38,10 @@ func New(options ...cfg.Option[Config]) (Metrics, error) {
 
 	switch config.metricsType {
 	case metricsViaProm:
-		return newPrometheus(config.serverPort)
+		return newPrometheus(config)
+	case metricsViaStatsD:
+		return newStatsD(config)
 	default:
-		return newPrometheus(config.serverPort)
+		return newPrometheus(config)
 	}
 }
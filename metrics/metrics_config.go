@@ -1,15 +1,28 @@
 package metrics
 
-import "github.com/zalgonoise/cfg"
+import (
+	"slices"
+	"time"
+
+	"github.com/zalgonoise/cfg"
+)
 
 const (
 	metricsViaProm = iota
+	metricsViaStatsD
 )
 
 type Config struct {
 	metricsType int
 
 	serverPort int
+
+	latencyBuckets []float64
+	driftBuckets   []float64
+
+	statsdAddr    string
+	prefix        string
+	flushInterval time.Duration
 }
 
 func ViaPrometheus() cfg.Option[Config] {
@@ -31,3 +44,100 @@ func WithPort(port int) cfg.Option[Config] {
 		return config
 	})
 }
+
+// WithLatencyBuckets sets the bucket boundaries, in seconds, of the Prometheus executions' latency histogram
+// (`executor_exec_latency`), which range from 10µs to 10s by default. Long-running jobs should set boundaries that
+// cover their expected durations, so their latency is not only registered in the overflow bucket.
+//
+// This call returns a cfg.NoOp cfg.Option if the input boundaries are empty or not in strictly increasing order.
+func WithLatencyBuckets(buckets ...float64) cfg.Option[Config] {
+	if !isIncreasing(buckets) {
+		return cfg.NoOp[Config]{}
+	}
+
+	buckets = slices.Clone(buckets)
+
+	return cfg.Register(func(config Config) Config {
+		config.latencyBuckets = buckets
+
+		return config
+	})
+}
+
+// WithDriftBuckets sets the bucket boundaries, in seconds, of the Prometheus executions' drift histogram
+// (`executor_exec_drift`), which range from 1ms to 60s by default.
+//
+// This call returns a cfg.NoOp cfg.Option if the input boundaries are empty or not in strictly increasing order.
+func WithDriftBuckets(buckets ...float64) cfg.Option[Config] {
+	if !isIncreasing(buckets) {
+		return cfg.NoOp[Config]{}
+	}
+
+	buckets = slices.Clone(buckets)
+
+	return cfg.Register(func(config Config) Config {
+		config.driftBuckets = buckets
+
+		return config
+	})
+}
+
+func isIncreasing(buckets []float64) bool {
+	if len(buckets) == 0 {
+		return false
+	}
+
+	for i := 1; i < len(buckets); i++ {
+		if !(buckets[i] > buckets[i-1]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ViaStatsD configures the Metrics to be sent to the (Dog)StatsD agent listening on the input UDP address.
+//
+// This call returns a cfg.NoOp cfg.Option if the input address is empty.
+func ViaStatsD(addr string) cfg.Option[Config] {
+	if addr == "" {
+		return cfg.NoOp[Config]{}
+	}
+
+	return cfg.Register(func(config Config) Config {
+		config.metricsType = metricsViaStatsD
+		config.statsdAddr = addr
+
+		return config
+	})
+}
+
+// WithPrefix sets the prefix for the names of the metrics sent to a StatsD agent, which is `micron.` by default.
+//
+// This call returns a cfg.NoOp cfg.Option if the input prefix is empty, keeping the default one.
+func WithPrefix(prefix string) cfg.Option[Config] {
+	if prefix == "" {
+		return cfg.NoOp[Config]{}
+	}
+
+	return cfg.Register(func(config Config) Config {
+		config.prefix = prefix
+
+		return config
+	})
+}
+
+// WithFlushInterval sets how often the buffered metrics are sent to a StatsD agent, which is every second by default.
+//
+// This call returns a cfg.NoOp cfg.Option if the input duration is zero or below.
+func WithFlushInterval(interval time.Duration) cfg.Option[Config] {
+	if interval <= 0 {
+		return cfg.NoOp[Config]{}
+	}
+
+	return cfg.Register(func(config Config) Config {
+		config.flushInterval = interval
+
+		return config
+	})
+}
@@ -14,9 +14,14 @@ type noOpMetrics struct{}
 func (noOpMetrics) IncSchedulerNextCalls()                                    {}
 func (noOpMetrics) IncSelectorSelectCalls()                                   {}
 func (noOpMetrics) IncSelectorSelectErrors()                                  {}
+func (noOpMetrics) ObserveRegisteredExecutors(int)                            {}
+func (noOpMetrics) ObserveSelectLatency(context.Context, time.Duration)       {}
 func (noOpMetrics) IncExecutorExecCalls(string)                               {}
-func (noOpMetrics) IncExecutorExecErrors(string)                              {}
+func (noOpMetrics) IncExecutorExecErrors(string, string)                      {}
 func (noOpMetrics) ObserveExecLatency(context.Context, string, time.Duration) {}
+func (noOpMetrics) ObserveExecDrift(context.Context, string, time.Duration)   {}
 func (noOpMetrics) IncExecutorNextCalls(string)                               {}
+func (noOpMetrics) IncExecutorRunErrors(string)                               {}
+func (noOpMetrics) IncExecutorSkippedRuns(string, string)                     {}
 func (noOpMetrics) IsUp(bool)                                                 {}
 func (noOpMetrics) Shutdown(context.Context) error                            { return nil }
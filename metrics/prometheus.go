@@ -10,6 +10,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/collectors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/zalgonoise/cfg"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -18,16 +19,28 @@ const (
 	defaultTimeout = 15 * time.Second
 )
 
+var (
+	//nolint:gochecknoglobals // immutable slice with the default latency histogram buckets, see WithLatencyBuckets
+	defaultLatencyBuckets = []float64{.00001, .00005, .0001, .0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+	//nolint:gochecknoglobals // immutable slice with the default drift histogram buckets, see WithDriftBuckets
+	defaultDriftBuckets = []float64{.001, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60}
+)
+
 type Prometheus struct {
 	server *http.Server
 
 	schedulerNextCount       prometheus.Counter
 	selectorSelectCount      prometheus.Counter
 	selectorSelectErrorCount prometheus.Counter
+	selectorExecutors        prometheus.Gauge
+	selectorSelectLatency    prometheus.Histogram
 	executorExecCount        *prometheus.CounterVec
 	executorExecErrorCount   *prometheus.CounterVec
 	executorLatency          *prometheus.HistogramVec
+	executorDrift            *prometheus.HistogramVec
 	executorNextCount        *prometheus.CounterVec
+	executorRunErrorCount    *prometheus.CounterVec
+	executorSkippedRunCount  *prometheus.CounterVec
 	cronUp                   prometheus.Gauge
 }
 
@@ -43,12 +56,31 @@ func (m *Prometheus) IncSelectorSelectErrors() {
 	m.selectorSelectErrorCount.Inc()
 }
 
+func (m *Prometheus) ObserveRegisteredExecutors(n int) {
+	m.selectorExecutors.Set(float64(n))
+}
+
+func (m *Prometheus) ObserveSelectLatency(ctx context.Context, dur time.Duration) {
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		//nolint:forcetypeassert // the underlying implementation implements ExemplarObserver by default
+		m.selectorSelectLatency.(prometheus.ExemplarObserver).
+			ObserveWithExemplar(
+				dur.Seconds(),
+				prometheus.Labels{traceIDKey: sc.TraceID().String()},
+			)
+
+		return
+	}
+
+	m.selectorSelectLatency.Observe(dur.Seconds())
+}
+
 func (m *Prometheus) IncExecutorExecCalls(id string) {
 	m.executorExecCount.WithLabelValues(id).Inc()
 }
 
-func (m *Prometheus) IncExecutorExecErrors(id string) {
-	m.executorExecErrorCount.WithLabelValues(id).Inc()
+func (m *Prometheus) IncExecutorExecErrors(id, runner string) {
+	m.executorExecErrorCount.WithLabelValues(id, runner).Inc()
 }
 
 func (m *Prometheus) ObserveExecLatency(ctx context.Context, id string, dur time.Duration) {
@@ -67,10 +99,34 @@ func (m *Prometheus) ObserveExecLatency(ctx context.Context, id string, dur time
 	m.executorLatency.WithLabelValues(id).Observe(dur.Seconds())
 }
 
+func (m *Prometheus) ObserveExecDrift(ctx context.Context, id string, drift time.Duration) {
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		//nolint:forcetypeassert // the underlying implementation implements ExemplarObserver by default
+		m.executorDrift.
+			WithLabelValues(id).(prometheus.ExemplarObserver).
+			ObserveWithExemplar(
+				drift.Seconds(),
+				prometheus.Labels{traceIDKey: sc.TraceID().String()},
+			)
+
+		return
+	}
+
+	m.executorDrift.WithLabelValues(id).Observe(drift.Seconds())
+}
+
 func (m *Prometheus) IncExecutorNextCalls(id string) {
 	m.executorNextCount.WithLabelValues(id).Inc()
 }
 
+func (m *Prometheus) IncExecutorRunErrors(id string) {
+	m.executorRunErrorCount.WithLabelValues(id).Inc()
+}
+
+func (m *Prometheus) IncExecutorSkippedRuns(id, reason string) {
+	m.executorSkippedRunCount.WithLabelValues(id, reason).Inc()
+}
+
 func (m *Prometheus) IsUp(up bool) {
 	if up {
 		m.cronUp.Set(1.0)
@@ -84,39 +140,126 @@ func (m *Prometheus) IsUp(up bool) {
 func (m *Prometheus) Registry() (*prometheus.Registry, error) {
 	reg := prometheus.NewRegistry()
 
-	for _, metric := range []prometheus.Collector{
+	if err := register(reg, append([]prometheus.Collector{
 		collectors.NewGoCollector(),
 		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{
 			ReportErrors: false,
 		}),
+	}, m.collectors()...)...); err != nil {
+		return nil, err
+	}
+
+	return reg, nil
+}
+
+func (m *Prometheus) collectors() []prometheus.Collector {
+	return []prometheus.Collector{
 		m.schedulerNextCount,
 		m.selectorSelectCount,
 		m.selectorSelectErrorCount,
+		m.selectorExecutors,
+		m.selectorSelectLatency,
 		m.executorExecCount,
 		m.executorExecErrorCount,
 		m.executorLatency,
+		m.executorDrift,
 		m.executorNextCount,
+		m.executorRunErrorCount,
+		m.executorSkippedRunCount,
 		m.cronUp,
-	} {
-		err := reg.Register(metric)
-		if err != nil {
-			return nil, err
-		}
 	}
-
-	return reg, nil
 }
 
 func (m *Prometheus) Shutdown(ctx context.Context) error {
+	// no server is started when registering the metrics in an existing registry
+	if m.server == nil {
+		return nil
+	}
+
 	return m.server.Shutdown(ctx)
 }
 
-func newPrometheus(port int) (Metrics, error) {
+func register(reg prometheus.Registerer, cs ...prometheus.Collector) error {
+	for _, metric := range cs {
+		if err := reg.Register(metric); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NewPrometheusWithRegistry creates a Prometheus Metrics implementation that registers its collectors in the input
+// prometheus.Registerer, also returning an error if raised.
+//
+// Unlike the Prometheus Metrics created with New, this call does not start an HTTP server, leaving it to the caller to
+// expose the registry's metrics in their own handler. If the input prometheus.Registerer is nil, the collectors are
+// registered in prometheus.DefaultRegisterer.
+//
+// The histograms' bucket boundaries are configurable with the WithLatencyBuckets and WithDriftBuckets options, while
+// any other options are ignored.
+func NewPrometheusWithRegistry(reg prometheus.Registerer, options ...cfg.Option[Config]) (*Prometheus, error) {
+	if reg == nil {
+		reg = prometheus.DefaultRegisterer
+	}
+
+	prom := newPrometheusCollectors(cfg.New(options...))
+
+	if err := register(reg, prom.collectors()...); err != nil {
+		return nil, err
+	}
+
+	return prom, nil
+}
+
+func newPrometheus(config Config) (Metrics, error) {
+	port := config.serverPort
 	if port <= 0 {
 		port = defaultPort
 	}
 
-	prom := &Prometheus{
+	prom := newPrometheusCollectors(config)
+
+	mux := http.NewServeMux()
+
+	reg, err := prom.Registry()
+	if err != nil {
+		return noOpMetrics{}, err
+	}
+
+	mux.Handle("/metrics", promhttp.HandlerFor(reg, promhttp.HandlerOpts{
+		Registry:          reg,
+		EnableOpenMetrics: true,
+	}))
+
+	prom.server = &http.Server{
+		Handler:      mux,
+		Addr:         fmt.Sprintf(":%d", port),
+		ReadTimeout:  defaultTimeout,
+		WriteTimeout: defaultTimeout,
+	}
+
+	go func() {
+		if err := prom.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			panic(err)
+		}
+	}()
+
+	return prom, nil
+}
+
+func newPrometheusCollectors(config Config) *Prometheus {
+	latencyBuckets := config.latencyBuckets
+	if len(latencyBuckets) == 0 {
+		latencyBuckets = defaultLatencyBuckets
+	}
+
+	driftBuckets := config.driftBuckets
+	if len(driftBuckets) == 0 {
+		driftBuckets = defaultDriftBuckets
+	}
+
+	return &Prometheus{
 		schedulerNextCount: prometheus.NewCounter(prometheus.CounterOpts{
 			Name: "scheduler_next_calls_total",
 			Help: "Count of time-calculations for the following scheduled task",
@@ -129,53 +272,48 @@ func newPrometheus(port int) (Metrics, error) {
 			Name: "selector_select_errors_total",
 			Help: "Count of errors when selecting the next task out of multiple executors",
 		}),
+		selectorExecutors: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "selector_registered_executors",
+			Help: "Number of executors registered in the selector",
+		}),
+		selectorSelectLatency: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    "selector_select_latency",
+			Help:    "Histogram of the time spent selecting the next task, excluding its execution",
+			Buckets: latencyBuckets,
+		}),
 		executorExecCount: prometheus.NewCounterVec(prometheus.CounterOpts{
 			Name: "executor_exec_calls_total",
 			Help: "Count of executions from a single executor, identified by its ID",
 		}, []string{"id"}),
 		executorExecErrorCount: prometheus.NewCounterVec(prometheus.CounterOpts{
 			Name: "executor_exec_errors_total",
-			Help: "Count of execution errors from a single executor, identified by its ID",
-		}, []string{"id"}),
+			Help: "Count of execution errors from a single executor, identified by its ID, and the failing runner's name",
+		}, []string{"id", "runner"}),
 		executorLatency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
 			Name:    "executor_exec_latency",
 			Help:    "Histogram of execution times",
-			Buckets: []float64{.00001, .00005, .0001, .0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+			Buckets: latencyBuckets,
+		}, []string{"id"}),
+		executorDrift: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "executor_exec_drift",
+			Help:    "Histogram of how late executions start, compared to their scheduled time",
+			Buckets: driftBuckets,
 		}, []string{"id"}),
 		executorNextCount: prometheus.NewCounterVec(prometheus.CounterOpts{
-			Name: "executor_exec_calls_total",
-			Help: "Count of calls to retrieve the next execution time",
+			Name: "executor_next_calls_total",
+			Help: "Count of calls to retrieve the next execution time from a single executor, identified by its ID",
 		}, []string{"id"}),
+		executorRunErrorCount: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "executor_run_errors_total",
+			Help: "Count of failed runner attempts, including retries, from a single executor, identified by its ID",
+		}, []string{"id"}),
+		executorSkippedRunCount: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "executor_skipped_runs_total",
+			Help: "Count of triggers that did not run, from a single executor identified by its ID, and the reason for the skip",
+		}, []string{"id", "reason"}),
 		cronUp: prometheus.NewGauge(prometheus.GaugeOpts{
 			Name: "cron_up",
 			Help: "Signals whether micron is running or not",
 		}),
 	}
-
-	mux := http.NewServeMux()
-
-	reg, err := prom.Registry()
-	if err != nil {
-		return noOpMetrics{}, err
-	}
-
-	mux.Handle("/metrics", promhttp.HandlerFor(reg, promhttp.HandlerOpts{
-		Registry:          reg,
-		EnableOpenMetrics: true,
-	}))
-
-	prom.server = &http.Server{
-		Handler:      mux,
-		Addr:         fmt.Sprintf(":%d", port),
-		ReadTimeout:  defaultTimeout,
-		WriteTimeout: defaultTimeout,
-	}
-
-	go func() {
-		if err := prom.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
-			panic(err)
-		}
-	}()
-
-	return prom, nil
 }
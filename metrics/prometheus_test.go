@@ -0,0 +1,163 @@
+package metrics
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/zalgonoise/cfg"
+	"github.com/zalgonoise/x/is"
+)
+
+func TestPrometheus_Registry(t *testing.T) {
+	const id = "test"
+
+	m := newPrometheusCollectors(Config{})
+
+	reg, err := m.Registry()
+	is.Empty(t, err)
+
+	// record a value in each metric so that all of them are present when gathering
+	m.IncSchedulerNextCalls()
+	m.IncSelectorSelectCalls()
+	m.IncSelectorSelectErrors()
+	m.ObserveRegisteredExecutors(3)
+	m.ObserveSelectLatency(context.Background(), time.Microsecond)
+	m.IncExecutorExecCalls(id)
+	m.IncExecutorExecErrors(id, "runner")
+	m.ObserveExecLatency(context.Background(), id, time.Millisecond)
+	m.ObserveExecDrift(context.Background(), id, time.Millisecond)
+	m.IncExecutorNextCalls(id)
+	m.IncExecutorRunErrors(id)
+	m.IncExecutorSkippedRuns(id, "overlap")
+	m.IsUp(true)
+
+	families, err := reg.Gather()
+	is.Empty(t, err)
+
+	names := make(map[string]struct{}, len(families))
+
+	for i := range families {
+		name := families[i].GetName()
+
+		_, ok := names[name]
+		is.False(t, ok)
+
+		names[name] = struct{}{}
+	}
+
+	for _, name := range []string{
+		"scheduler_next_calls_total",
+		"selector_select_calls_total",
+		"selector_select_errors_total",
+		"selector_registered_executors",
+		"selector_select_latency",
+		"executor_exec_calls_total",
+		"executor_exec_errors_total",
+		"executor_exec_latency",
+		"executor_exec_drift",
+		"executor_next_calls_total",
+		"executor_run_errors_total",
+		"executor_skipped_runs_total",
+		"cron_up",
+	} {
+		_, ok := names[name]
+		is.True(t, ok)
+	}
+}
+
+func TestNewPrometheusWithRegistry(t *testing.T) {
+	reg := prometheus.NewRegistry()
+
+	m, err := NewPrometheusWithRegistry(reg)
+	is.Empty(t, err)
+
+	m.IncExecutorExecCalls("job")
+
+	// the collectors are already registered
+	_, err = NewPrometheusWithRegistry(reg)
+	is.True(t, err != nil)
+
+	mux := http.NewServeMux()
+	mux.Handle("/custom/metrics", promhttp.HandlerFor(reg, promhttp.HandlerOpts{}))
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	res, err := http.Get(server.URL + "/custom/metrics")
+	is.Empty(t, err)
+
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	is.Empty(t, err)
+	is.True(t, strings.Contains(string(body), `executor_exec_calls_total{id="job"} 1`))
+
+	// no server is started, so there is nothing to shut down
+	is.Empty(t, m.Shutdown(context.Background()))
+}
+
+func TestPrometheus_Buckets(t *testing.T) {
+	for _, testcase := range []struct {
+		name          string
+		opts          []cfg.Option[Config]
+		latencyBounds []float64
+		driftBounds   []float64
+	}{
+		{
+			name:          "Defaults",
+			latencyBounds: defaultLatencyBuckets,
+			driftBounds:   defaultDriftBuckets,
+		},
+		{
+			name: "Custom",
+			opts: []cfg.Option[Config]{
+				WithLatencyBuckets(1, 30, 60, 300, 900),
+				WithDriftBuckets(.5, 5),
+			},
+			latencyBounds: []float64{1, 30, 60, 300, 900},
+			driftBounds:   []float64{.5, 5},
+		},
+		{
+			name: "InvalidBucketsKeepDefaults",
+			opts: []cfg.Option[Config]{
+				WithLatencyBuckets(),
+				WithDriftBuckets(5, 5, 10),
+			},
+			latencyBounds: defaultLatencyBuckets,
+			driftBounds:   defaultDriftBuckets,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			reg := prometheus.NewRegistry()
+
+			m, err := NewPrometheusWithRegistry(reg, testcase.opts...)
+			is.Empty(t, err)
+
+			m.ObserveExecLatency(context.Background(), "job", time.Second)
+			m.ObserveExecDrift(context.Background(), "job", time.Second)
+
+			families, err := reg.Gather()
+			is.Empty(t, err)
+
+			bounds := make(map[string][]float64, len(families))
+
+			for i := range families {
+				for _, metric := range families[i].GetMetric() {
+					for _, bucket := range metric.GetHistogram().GetBucket() {
+						bounds[families[i].GetName()] = append(bounds[families[i].GetName()], bucket.GetUpperBound())
+					}
+				}
+			}
+
+			is.EqualElements(t, testcase.latencyBounds, bounds["executor_exec_latency"])
+			is.EqualElements(t, testcase.driftBounds, bounds["executor_exec_drift"])
+		})
+	}
+}
@@ -0,0 +1,272 @@
+package metrics
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/zalgonoise/cfg"
+)
+
+const (
+	defaultStatsDPrefix        = "micron."
+	defaultStatsDFlushInterval = time.Second
+	// defaultStatsDPacketSize keeps each UDP datagram within a common network MTU.
+	defaultStatsDPacketSize = 1432
+
+	// defaultStatsDLineSize is the initial capacity of a formatted metric line, enough for most metrics.
+	defaultStatsDLineSize = 128
+
+	statsdCounter = "c"
+	statsdTimer   = "ms"
+	statsdGauge   = "g"
+)
+
+// tagReplacer replaces the characters that are reserved in the DogStatsD datagram format.
+//
+//nolint:gochecknoglobals // immutable, concurrency-safe strings.Replacer, built once and shared by all StatsD instances
+var tagReplacer = strings.NewReplacer(",", "_", "|", "_", "#", "_", "\n", "_")
+
+// StatsD is a Metrics implementation that sends its metrics to a (Dog)StatsD agent over UDP.
+//
+// Metrics are buffered and sent in batches, either once the buffer fills up a datagram or on each flush interval. It
+// is safe for concurrent use.
+type StatsD struct {
+	conn       net.Conn
+	prefix     string
+	packetSize int
+
+	mu  sync.Mutex
+	buf []byte
+
+	// dropped counts the batches that failed to be sent, as UDP writes are fire-and-forget.
+	dropped atomic.Uint64
+
+	done chan struct{}
+	wg   sync.WaitGroup
+
+	// stopOnce and closeOnce make Shutdown idempotent
+	stopOnce  sync.Once
+	closeOnce sync.Once
+}
+
+func (m *StatsD) IncSchedulerNextCalls() {
+	m.send("scheduler.next.calls", "1", statsdCounter)
+}
+
+func (m *StatsD) IncSelectorSelectCalls() {
+	m.send("selector.select.calls", "1", statsdCounter)
+}
+
+func (m *StatsD) IncSelectorSelectErrors() {
+	m.send("selector.select.errors", "1", statsdCounter)
+}
+
+func (m *StatsD) ObserveRegisteredExecutors(n int) {
+	m.send("selector.registered.executors", strconv.Itoa(n), statsdGauge)
+}
+
+func (m *StatsD) ObserveSelectLatency(_ context.Context, dur time.Duration) {
+	m.send("selector.select.latency",
+		strconv.FormatFloat(float64(dur)/float64(time.Millisecond), 'f', -1, 64), statsdTimer,
+	)
+}
+
+func (m *StatsD) IncExecutorExecCalls(id string) {
+	m.send("executor.exec.calls", "1", statsdCounter, "id", id)
+}
+
+func (m *StatsD) IncExecutorExecErrors(id, runner string) {
+	if runner == "" {
+		m.send("executor.exec.errors", "1", statsdCounter, "id", id)
+
+		return
+	}
+
+	m.send("executor.exec.errors", "1", statsdCounter, "id", id, "runner", runner)
+}
+
+func (m *StatsD) ObserveExecLatency(_ context.Context, id string, dur time.Duration) {
+	m.send("executor.exec.latency",
+		strconv.FormatFloat(float64(dur)/float64(time.Millisecond), 'f', -1, 64), statsdTimer,
+		"id", id,
+	)
+}
+
+func (m *StatsD) ObserveExecDrift(_ context.Context, id string, drift time.Duration) {
+	m.send("executor.exec.drift",
+		strconv.FormatFloat(float64(drift)/float64(time.Millisecond), 'f', -1, 64), statsdTimer,
+		"id", id,
+	)
+}
+
+func (m *StatsD) IncExecutorNextCalls(id string) {
+	m.send("executor.next.calls", "1", statsdCounter, "id", id)
+}
+
+func (m *StatsD) IncExecutorRunErrors(id string) {
+	m.send("executor.run.errors", "1", statsdCounter, "id", id)
+}
+
+func (m *StatsD) IncExecutorSkippedRuns(id, reason string) {
+	m.send("executor.skipped.runs", "1", statsdCounter, "id", id, "reason", reason)
+}
+
+func (m *StatsD) IsUp(up bool) {
+	if up {
+		m.send("cron.up", "1", statsdGauge)
+
+		return
+	}
+
+	m.send("cron.up", "0", statsdGauge)
+}
+
+// Shutdown stops the periodic flushes, sends any buffered metrics and closes the connection to the StatsD agent.
+//
+// It is safe to call Shutdown more than once: once the connection is closed, further calls return nil.
+func (m *StatsD) Shutdown(ctx context.Context) (err error) {
+	m.stopOnce.Do(func() { close(m.done) })
+
+	stopped := make(chan struct{})
+
+	go func() {
+		m.wg.Wait()
+		close(stopped)
+	}()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-stopped:
+	}
+
+	m.closeOnce.Do(func() {
+		m.flush()
+
+		err = m.conn.Close()
+	})
+
+	return err
+}
+
+// send formats the metric as a DogStatsD line, like `micron.executor.exec.calls:1|c|#id:job`, adding it to the buffer.
+//
+// The input tags are a sequence of key-value pairs.
+func (m *StatsD) send(name, value, kind string, tags ...string) {
+	line := make([]byte, 0, defaultStatsDLineSize)
+	line = append(line, m.prefix...)
+	line = append(line, name...)
+	line = append(line, ':')
+	line = append(line, value...)
+	line = append(line, '|')
+	line = append(line, kind...)
+
+	for i := 0; i+1 < len(tags); i += 2 {
+		if i == 0 {
+			line = append(line, "|#"...)
+		} else {
+			line = append(line, ',')
+		}
+
+		line = append(line, tags[i]...)
+		line = append(line, ':')
+		line = append(line, tagReplacer.Replace(tags[i+1])...)
+	}
+
+	line = append(line, '\n')
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(m.buf)+len(line) > m.packetSize {
+		m.flushLocked()
+	}
+
+	m.buf = append(m.buf, line...)
+}
+
+func (m *StatsD) flush() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.flushLocked()
+}
+
+func (m *StatsD) flushLocked() {
+	if len(m.buf) == 0 {
+		return
+	}
+
+	if _, err := m.conn.Write(m.buf); err != nil {
+		m.dropped.Add(1)
+	}
+
+	m.buf = m.buf[:0]
+}
+
+func (m *StatsD) run(interval time.Duration) {
+	defer m.wg.Done()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.done:
+			return
+		case <-ticker.C:
+			m.flush()
+		}
+	}
+}
+
+// NewStatsD creates a Metrics implementation that sends its metrics to the (Dog)StatsD agent listening on the input
+// UDP address, also returning an error if raised.
+//
+// The metrics are named with a `micron.` prefix by default, which can be changed with the WithPrefix option, and are
+// tagged with the executor's ID where applicable. They are sent in batches on each flush interval (one second by
+// default, configurable with the WithFlushInterval option), or as soon as a batch fills up a datagram.
+//
+// If the address cannot be resolved, a no-op Metrics is returned along with the error.
+func NewStatsD(addr string, options ...cfg.Option[Config]) (Metrics, error) {
+	config := cfg.New(options...)
+	config.statsdAddr = addr
+
+	return newStatsD(config)
+}
+
+func newStatsD(config Config) (Metrics, error) {
+	conn, err := net.Dial("udp", config.statsdAddr)
+	if err != nil {
+		return noOpMetrics{}, err
+	}
+
+	prefix := config.prefix
+	if prefix == "" {
+		prefix = defaultStatsDPrefix
+	}
+
+	interval := config.flushInterval
+	if interval <= 0 {
+		interval = defaultStatsDFlushInterval
+	}
+
+	m := &StatsD{
+		conn:       conn,
+		prefix:     prefix,
+		packetSize: defaultStatsDPacketSize,
+		buf:        make([]byte, 0, defaultStatsDPacketSize),
+		done:       make(chan struct{}),
+	}
+
+	m.wg.Add(1)
+
+	go m.run(interval)
+
+	return m, nil
+}
@@ -0,0 +1,113 @@
+package metrics
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zalgonoise/x/is"
+)
+
+func TestStatsD(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	is.Empty(t, err)
+
+	defer conn.Close()
+
+	m, err := NewStatsD(conn.LocalAddr().String(), WithFlushInterval(time.Hour))
+	is.Empty(t, err)
+
+	m.IncSchedulerNextCalls()
+	m.IncSelectorSelectCalls()
+	m.IncSelectorSelectErrors()
+	m.ObserveRegisteredExecutors(3)
+	m.ObserveSelectLatency(context.Background(), 250*time.Microsecond)
+	m.IncExecutorExecCalls("job")
+	m.IncExecutorExecErrors("job", "")
+	m.IncExecutorExecErrors("job", "a,b|c")
+	m.ObserveExecLatency(context.Background(), "job", 1500*time.Microsecond)
+	m.ObserveExecDrift(context.Background(), "job", 100*time.Millisecond)
+	m.IncExecutorNextCalls("job")
+	m.IncExecutorRunErrors("job")
+	m.IncExecutorSkippedRuns("job", "overlap")
+	m.IsUp(true)
+	m.IsUp(false)
+
+	// shutting down flushes the buffered metrics
+	is.Empty(t, m.Shutdown(context.Background()))
+
+	// shutting down again is a no-op
+	is.Empty(t, m.Shutdown(context.Background()))
+
+	buf := make([]byte, defaultStatsDPacketSize)
+
+	is.Empty(t, conn.SetReadDeadline(time.Now().Add(time.Second)))
+
+	n, _, err := conn.ReadFrom(buf)
+	is.Empty(t, err)
+
+	is.Equal(t, strings.Join([]string{
+		"micron.scheduler.next.calls:1|c",
+		"micron.selector.select.calls:1|c",
+		"micron.selector.select.errors:1|c",
+		"micron.selector.registered.executors:3|g",
+		"micron.selector.select.latency:0.25|ms",
+		"micron.executor.exec.calls:1|c|#id:job",
+		"micron.executor.exec.errors:1|c|#id:job",
+		"micron.executor.exec.errors:1|c|#id:job,runner:a_b_c",
+		"micron.executor.exec.latency:1.5|ms|#id:job",
+		"micron.executor.exec.drift:100|ms|#id:job",
+		"micron.executor.next.calls:1|c|#id:job",
+		"micron.executor.run.errors:1|c|#id:job",
+		"micron.executor.skipped.runs:1|c|#id:job,reason:overlap",
+		"micron.cron.up:1|g",
+		"micron.cron.up:0|g",
+		"",
+	}, "\n"), string(buf[:n]))
+}
+
+func TestStatsD_Batching(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	is.Empty(t, err)
+
+	defer conn.Close()
+
+	m, err := NewStatsD(conn.LocalAddr().String(),
+		WithPrefix("app."),
+		WithFlushInterval(10*time.Millisecond),
+	)
+	is.Empty(t, err)
+
+	defer m.Shutdown(context.Background())
+
+	m.IncExecutorExecCalls("job")
+
+	buf := make([]byte, defaultStatsDPacketSize)
+
+	// metrics are sent on each flush interval
+	is.Empty(t, conn.SetReadDeadline(time.Now().Add(time.Second)))
+
+	n, _, err := conn.ReadFrom(buf)
+	is.Empty(t, err)
+	is.Equal(t, "app.executor.exec.calls:1|c|#id:job\n", string(buf[:n]))
+
+	// metrics are sent as soon as a batch fills up a datagram
+	const numMetrics = 100
+
+	for i := 0; i < numMetrics; i++ {
+		m.IncExecutorExecCalls("job")
+	}
+
+	n, _, err = conn.ReadFrom(buf)
+	is.Empty(t, err)
+	is.True(t, n <= defaultStatsDPacketSize)
+	is.True(t, strings.HasPrefix(string(buf[:n]), "app.executor.exec.calls:1|c|#id:job\n"))
+}
+
+func TestNewStatsD_Unreachable(t *testing.T) {
+	m, err := NewStatsD("not a valid address")
+	is.True(t, err != nil)
+	is.Equal(t, NoOp(), m)
+}
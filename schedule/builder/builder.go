@@ -3,6 +3,7 @@ package builder
 import (
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/zalgonoise/micron/schedule/cronlex"
 	"github.com/zalgonoise/micron/schedule/resolve"
@@ -51,6 +52,9 @@ var (
 	ErrInvalidCategory = errors.New("invalid category")
 	ErrInvalidResolver = errors.New("invalid resolver type")
 	ErrOutOfBounds     = errors.New("value is out-of-bounds")
+	ErrInvalidStep     = errors.New("step must be greater than zero")
+	ErrInvertedRange   = errors.New("range start is after its end")
+	ErrEmptySteps      = errors.New("no step values provided")
 )
 
 type Scheduler interface {
@@ -65,118 +69,67 @@ type Scheduler interface {
 type Resolver struct {
 	category int
 	resolver cronlex.Resolver
+	err      error
 }
 
 type schedule struct {
 	value int
 }
 
-func (s schedule) Seconds() Resolver {
+func (s schedule) resolver(category, maximum int) Resolver {
 	if s.value < 0 {
 		return Resolver{
-			category: seconds,
+			category: category,
 			resolver: resolve.Everytime{},
 		}
 	}
 
-	return Resolver{
-		category: seconds,
-		resolver: resolve.FixedSchedule{
-			Max: maxSecond,
-			At:  s.value,
-		},
-	}
-}
-
-func (s schedule) Minutes() Resolver {
-	if s.value < 0 {
+	r, err := resolve.NewFixedSchedule(s.value, maximum)
+	if err != nil {
 		return Resolver{
-			category: minutes,
-			resolver: resolve.Everytime{},
+			category: category,
+			err:      fmt.Errorf("%w: %w", ErrOutOfBounds, err),
 		}
 	}
 
 	return Resolver{
-		category: minutes,
-		resolver: resolve.FixedSchedule{
-			Max: maxMinute,
-			At:  s.value,
-		},
+		category: category,
+		resolver: r,
 	}
 }
 
-func (s schedule) Hours() Resolver {
-	if s.value < 0 {
-		return Resolver{
-			category: hours,
-			resolver: resolve.Everytime{},
-		}
-	}
+func (s schedule) Seconds() Resolver {
+	return s.resolver(seconds, maxSecond)
+}
 
-	return Resolver{
-		category: hours,
-		resolver: resolve.FixedSchedule{
-			Max: maxHour,
-			At:  s.value,
-		},
-	}
+func (s schedule) Minutes() Resolver {
+	return s.resolver(minutes, maxMinute)
 }
 
-func (s schedule) MonthDays() Resolver {
-	if s.value < 0 {
-		return Resolver{
-			category: monthDays,
-			resolver: resolve.Everytime{},
-		}
-	}
+func (s schedule) Hours() Resolver {
+	return s.resolver(hours, maxHour)
+}
 
-	return Resolver{
-		category: monthDays,
-		resolver: resolve.FixedSchedule{
-			Max: maxDay,
-			At:  s.value,
-		},
-	}
+func (s schedule) MonthDays() Resolver {
+	return s.resolver(monthDays, maxDay)
 }
 
 func (s schedule) Months() Resolver {
-	if s.value < 0 {
-		return Resolver{
-			category: months,
-			resolver: resolve.Everytime{},
-		}
-	}
-
-	return Resolver{
-		category: months,
-		resolver: resolve.FixedSchedule{
-			Max: maxMonth,
-			At:  s.value,
-		},
-	}
+	return s.resolver(months, maxMonth)
 }
 
 func (s schedule) Weekdays() Resolver {
-	if s.value < 0 {
-		return Resolver{
-			category: weekdays,
-			resolver: resolve.Everytime{},
-		}
-	}
-
-	return Resolver{
-		category: weekdays,
-		resolver: resolve.FixedSchedule{
-			Max: maxWeekday,
-			At:  s.value,
-		},
-	}
+	return s.resolver(weekdays, maxWeekday)
 }
 
 func All() Scheduler {
 	return schedule{value: -1}
 }
 
+// Every returns a Scheduler that triggers when the field is at value n, like a fixed `n` in a cron string
+// (e.g. Every(5).Minutes() runs at minute 5 of every hour).
+//
+// To trigger every n units of a field, like `*/n` in a cron string, use Step instead.
 func Every(n int) Scheduler {
 	return schedule{value: n}
 }
@@ -185,70 +138,43 @@ type rangeSchedule struct {
 	from, to int
 }
 
-func (s rangeSchedule) Seconds() Resolver {
+func (s rangeSchedule) resolver(category, maximum int) Resolver {
+	r, err := resolve.NewRangeSchedule(s.from, s.to, maximum)
+	if err != nil {
+		return Resolver{
+			category: category,
+			err:      fmt.Errorf("%w: %w", ErrOutOfBounds, err),
+		}
+	}
+
 	return Resolver{
-		category: seconds,
-		resolver: resolve.RangeSchedule{
-			Max:  maxSecond,
-			From: s.from,
-			To:   s.to,
-		},
+		category: category,
+		resolver: r,
 	}
 }
 
+func (s rangeSchedule) Seconds() Resolver {
+	return s.resolver(seconds, maxSecond)
+}
+
 func (s rangeSchedule) Minutes() Resolver {
-	return Resolver{
-		category: minutes,
-		resolver: resolve.RangeSchedule{
-			Max:  maxMinute,
-			From: s.from,
-			To:   s.to,
-		},
-	}
+	return s.resolver(minutes, maxMinute)
 }
 
 func (s rangeSchedule) Hours() Resolver {
-	return Resolver{
-		category: hours,
-		resolver: resolve.RangeSchedule{
-			Max:  maxHour,
-			From: s.from,
-			To:   s.to,
-		},
-	}
+	return s.resolver(hours, maxHour)
 }
 
 func (s rangeSchedule) MonthDays() Resolver {
-	return Resolver{
-		category: monthDays,
-		resolver: resolve.RangeSchedule{
-			Max:  maxDay,
-			From: s.from,
-			To:   s.to,
-		},
-	}
+	return s.resolver(monthDays, maxDay)
 }
 
 func (s rangeSchedule) Months() Resolver {
-	return Resolver{
-		category: months,
-		resolver: resolve.RangeSchedule{
-			Max:  maxMonth,
-			From: s.from,
-			To:   s.to,
-		},
-	}
+	return s.resolver(months, maxMonth)
 }
 
 func (s rangeSchedule) Weekdays() Resolver {
-	return Resolver{
-		category: weekdays,
-		resolver: resolve.RangeSchedule{
-			Max:  maxWeekday,
-			From: s.from,
-			To:   s.to,
-		},
-	}
+	return s.resolver(weekdays, maxWeekday)
 }
 
 func Range(from, to int) Scheduler {
@@ -323,7 +249,62 @@ func (s stepSchedule) Weekdays() Resolver {
 }
 
 func On(values ...int) Scheduler {
-	return stepSchedule{values: values}
+	values = slices.Clone(values)
+
+	slices.Sort(values)
+
+	return stepSchedule{values: slices.Compact(values)}
+}
+
+type strideSchedule struct {
+	step int
+}
+
+func (s strideSchedule) resolver(category, minimum, maximum, last int) Resolver {
+	if s.step < 1 {
+		return Resolver{
+			category: category,
+			err:      fmt.Errorf("%w: %d", ErrInvalidStep, s.step),
+		}
+	}
+
+	return Resolver{
+		category: category,
+		resolver: resolve.NewStepSchedule(minimum, last, maximum, s.step),
+	}
+}
+
+func (s strideSchedule) Seconds() Resolver {
+	return s.resolver(seconds, minSecond, maxSecond, maxSecond)
+}
+
+func (s strideSchedule) Minutes() Resolver {
+	return s.resolver(minutes, minMinute, maxMinute, maxMinute)
+}
+
+func (s strideSchedule) Hours() Resolver {
+	return s.resolver(hours, minHour, maxHour, maxHour)
+}
+
+func (s strideSchedule) MonthDays() Resolver {
+	return s.resolver(monthDays, minDay, maxDay, maxDay)
+}
+
+func (s strideSchedule) Months() Resolver {
+	return s.resolver(months, minMonth, maxMonth, maxMonth)
+}
+
+func (s strideSchedule) Weekdays() Resolver {
+	// Sunday is only included once, as 0, since maxWeekday is its alias
+	return s.resolver(weekdays, minWeekday, maxWeekday, Saturday)
+}
+
+// Step returns a Scheduler that triggers every n units of a field, across its full range, like `*/n` in a cron string
+// (e.g. Step(15).Minutes() runs at minutes 0, 15, 30 and 45 of every hour).
+//
+// Building a schedule with a step of zero or below returns an ErrInvalidStep error.
+func Step(n int) Scheduler {
+	return strideSchedule{step: n}
 }
 
 func Build(resolvers ...Resolver) (*cronlex.Schedule, error) {
@@ -353,6 +334,19 @@ func Build(resolvers ...Resolver) (*cronlex.Schedule, error) {
 	return populateSchedule(sched), nil
 }
 
+// BuildString builds a schedule from the input Resolver(s) like Build does, returning its canonical cron string.
+//
+// The returned string parses back (with cronlex.Parse) into the same schedule, so that schedules built
+// programmatically can be persisted or configured as cron strings.
+func BuildString(resolvers ...Resolver) (string, error) {
+	sched, err := Build(resolvers...)
+	if err != nil {
+		return "", err
+	}
+
+	return sched.String(), nil
+}
+
 func populateMinutes(start bool, sched *cronlex.Schedule) (bool, *cronlex.Schedule) {
 	switch {
 	case sched.Min == nil && !start:
@@ -436,6 +430,10 @@ func populateSchedule(sched *cronlex.Schedule) *cronlex.Schedule {
 }
 
 func validateResolver(r Resolver) error {
+	if r.err != nil {
+		return r.err
+	}
+
 	switch r.category {
 	case seconds:
 		return validate(r, minSecond)
@@ -474,11 +472,19 @@ func validate(r Resolver, minimum int) error {
 		}
 
 		if v.To < minimum || v.To > v.Max {
-			return errors.Join(err, fmt.Errorf("%w: to: %d", ErrOutOfBounds, v.From))
+			err = errors.Join(err, fmt.Errorf("%w: to: %d", ErrOutOfBounds, v.To))
+		}
+
+		if v.From > v.To {
+			err = errors.Join(err, fmt.Errorf("%w: from: %d; to: %d", ErrInvertedRange, v.From, v.To))
 		}
 
 		return err
 	case resolve.StepSchedule:
+		if len(v.Steps) == 0 {
+			return ErrEmptySteps
+		}
+
 		errs := make([]error, 0, len(v.Steps))
 
 		for i := range v.Steps {
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/stretchr/testify/require"
 	"github.com/zalgonoise/micron/schedule/cronlex"
 	"github.com/zalgonoise/micron/schedule/resolve"
 )
@@ -129,16 +130,227 @@ func TestBuild(t *testing.T) {
 	}
 }
 
+func TestBuildErrors(t *testing.T) {
+	for _, testcase := range []struct {
+		name      string
+		resolvers []Resolver
+		err       error
+	}{
+		{
+			name:      "InvertedRange",
+			resolvers: []Resolver{Range(10, 2).Hours()},
+			err:       ErrInvertedRange,
+		},
+		{
+			name:      "InvertedRangeOutOfBounds",
+			resolvers: []Resolver{Range(70, 2).Minutes()},
+			err:       ErrOutOfBounds,
+		},
+		{
+			name:      "RangeToOutOfBounds",
+			resolvers: []Resolver{Range(Monday, 9).Weekdays()},
+			err:       ErrOutOfBounds,
+		},
+		{
+			name:      "EmptySteps",
+			resolvers: []Resolver{On().MonthDays()},
+			err:       ErrEmptySteps,
+		},
+		{
+			name:      "StepOutOfBounds",
+			resolvers: []Resolver{On(0, 6).Months()},
+			err:       ErrOutOfBounds,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			sched, err := Build(testcase.resolvers...)
+			isEqual(t, true, errors.Is(err, testcase.err))
+			isEqual(t, true, sched == nil)
+		})
+	}
+
+	t.Run("SingleValueRange", func(t *testing.T) {
+		_, err := Build(Range(Friday, Friday).Weekdays())
+		isEqual(t, nil, err)
+	})
+}
+
+func TestBuildString(t *testing.T) {
+	for _, testcase := range []struct {
+		name      string
+		resolvers []Resolver
+		wants     string
+		err       error
+	}{
+		{
+			name:      "EveryMinute",
+			resolvers: []Resolver{All().Minutes()},
+			wants:     "* * * * *",
+		},
+		{
+			name:      "EverySecond",
+			resolvers: []Resolver{All().Seconds()},
+			wants:     "* * * * * *",
+		},
+		{
+			name:      "At5thMonth",
+			resolvers: []Resolver{Every(5).Months()},
+			wants:     "0 0 1 5 *",
+		},
+		{
+			name: "WorkdaysAtNine",
+			resolvers: []Resolver{
+				Every(30).Minutes(),
+				Every(9).Hours(),
+				Range(Monday, Friday).Weekdays(),
+			},
+			wants: "30 9 * * 1-5",
+		},
+		{
+			name:      "Step15Minutes",
+			resolvers: []Resolver{Step(15).Minutes()},
+			wants:     "0,15,30,45 * * * *",
+		},
+		{
+			name:      "OnAFewDaysOfEveryMonth",
+			resolvers: []Resolver{On(1, 3, 4, 7, 10).MonthDays()},
+			wants:     "0 0 1,3,4,7,10 * *",
+		},
+		{
+			name:      "Fail/InvertedRange",
+			resolvers: []Resolver{Range(10, 2).Hours()},
+			err:       ErrInvertedRange,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			cron, err := BuildString(testcase.resolvers...)
+			isEqual(t, true, errors.Is(err, testcase.err))
+			isEqual(t, testcase.wants, cron)
+
+			if testcase.err != nil {
+				return
+			}
+
+			// the cron string must parse back into the same schedule
+			sched, err := Build(testcase.resolvers...)
+			isEqual(t, nil, err)
+
+			parsed, err := cronlex.Parse(cron)
+			isEqual(t, nil, err)
+			require.Equal(t, *sched, parsed)
+		})
+	}
+}
+
+func TestEveryAndStep(t *testing.T) {
+	for _, testcase := range []struct {
+		name     string
+		resolver Resolver
+		wants    cronlex.Resolver
+		err      error
+	}{
+		{
+			name:     "Every5Minutes/AtMinute5",
+			resolver: Every(5).Minutes(),
+			wants: resolve.FixedSchedule{
+				Max: maxMinute,
+				At:  5,
+			},
+		},
+		{
+			name:     "Step15Minutes",
+			resolver: Step(15).Minutes(),
+			wants: resolve.StepSchedule{
+				Max:   maxMinute,
+				Steps: []int{0, 15, 30, 45},
+			},
+		},
+		{
+			name:     "Step20Seconds",
+			resolver: Step(20).Seconds(),
+			wants: resolve.StepSchedule{
+				Max:   maxSecond,
+				Steps: []int{0, 20, 40},
+			},
+		},
+		{
+			name:     "Step6Hours",
+			resolver: Step(6).Hours(),
+			wants: resolve.StepSchedule{
+				Max:   maxHour,
+				Steps: []int{0, 6, 12, 18},
+			},
+		},
+		{
+			name:     "Step10MonthDays",
+			resolver: Step(10).MonthDays(),
+			wants: resolve.StepSchedule{
+				Max:   maxDay,
+				Steps: []int{1, 11, 21, 31},
+			},
+		},
+		{
+			name:     "Step3Months",
+			resolver: Step(3).Months(),
+			wants: resolve.StepSchedule{
+				Max:   maxMonth,
+				Steps: []int{1, 4, 7, 10},
+			},
+		},
+		{
+			name:     "Step1Weekdays",
+			resolver: Step(1).Weekdays(),
+			wants: resolve.StepSchedule{
+				Max:   maxWeekday,
+				Steps: []int{0, 1, 2, 3, 4, 5, 6},
+			},
+		},
+		{
+			name:     "Fail/ZeroStep",
+			resolver: Step(0).Minutes(),
+			err:      ErrInvalidStep,
+		},
+		{
+			name:     "Fail/NegativeStep",
+			resolver: Step(-2).Hours(),
+			err:      ErrInvalidStep,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			sched, err := Build(testcase.resolver)
+			isEqual(t, true, errors.Is(err, testcase.err))
+
+			if testcase.err != nil {
+				return
+			}
+
+			var got cronlex.Resolver
+
+			switch testcase.resolver.category {
+			case seconds:
+				got = sched.Sec
+			case minutes:
+				got = sched.Min
+			case hours:
+				got = sched.Hour
+			case monthDays:
+				got = sched.DayMonth
+			case months:
+				got = sched.Month
+			case weekdays:
+				got = sched.DayWeek
+			}
+
+			isEqualResolver(t, testcase.wants, got)
+		})
+	}
+}
+
 func isEqualResolver(t *testing.T, wants, got cronlex.Resolver) {
 	if steps, ok := wants.(resolve.StepSchedule); ok {
 		got, ok := got.(resolve.StepSchedule)
 
-		isEqual(t, true, ok)
-		isEqual(t, steps.Max, got.Max)
-
-		for i := range steps.Steps {
-			isEqual(t, steps.Steps[i], got.Steps[i])
-		}
+		isEqual(t, true, ok && steps.Equal(got))
 
 		return
 	}
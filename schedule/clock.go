@@ -0,0 +1,30 @@
+package schedule
+
+import "time"
+
+// Clock describes a source for the current time, which can be replaced with a fake one in tests and simulations.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+}
+
+type realClock struct{}
+
+// Now returns the current time, with time.Now.
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// resolveTime returns the input time.Time, or the current time from the input Clock if it is zero. A nil Clock
+// defaults to the real clock.
+func resolveTime(clock Clock, t time.Time) time.Time {
+	if !t.IsZero() {
+		return t
+	}
+
+	if clock == nil {
+		return time.Now()
+	}
+
+	return clock.Now()
+}
@@ -0,0 +1,245 @@
+package cronlex
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zalgonoise/micron/schedule/resolve"
+)
+
+const (
+	clockFormat        = "3:04 PM"
+	clockFormatWithSec = "3:04:05 PM"
+	hourFormat         = "3 PM"
+	lastMinute         = 59
+	daysInWeek         = 7
+	timeFields         = 3
+	pairOfValues       = 2
+)
+
+// Describe parses the input cron string and returns an English description of its Schedule, also returning an error
+// if raised.
+//
+// For example, the cron string `0 9 * * 1-5` is described as "At 9:00 AM, Monday through Friday".
+func Describe(cron string) (string, error) {
+	s, err := Parse(cron)
+	if err != nil {
+		return "", err
+	}
+
+	return s.Describe(), nil
+}
+
+// Describe returns an English description of the Schedule, such as "At 9:00 AM, Monday through Friday".
+//
+// The description is deterministic, covering fixed values, ranges and step lists in each of the Schedule's fields.
+func (s Schedule) Describe() string {
+	switch {
+	case s.Once:
+		return "At startup"
+	case s.Every > 0:
+		return "Every " + s.Every.String()
+	}
+
+	phrases := make([]string, 0, withYears)
+	phrases = append(phrases, describeTime(s.Sec, s.Min, s.Hour)...)
+
+	dayMonth := describeField(s.DayMonth, "on day", "on days", "of the month", strconv.Itoa)
+	dayWeek := describeField(s.DayWeek, "only on", "only on", "", weekdayName)
+
+	// when both days of the month and of the week are restricted, either of them is an occurrence
+	if dayMonth != "" && dayWeek != "" {
+		dayMonth += " or " + describeField(s.DayWeek, "on", "on", "", weekdayName)
+		dayWeek = ""
+	}
+
+	if dayMonth != "" {
+		phrases = append(phrases, dayMonth)
+	}
+
+	if phrase := describeField(s.Month, "only in", "only in", "", monthName); phrase != "" {
+		phrases = append(phrases, phrase)
+	}
+
+	if dayWeek != "" {
+		phrases = append(phrases, dayWeek)
+	}
+
+	if phrase := describeField(s.Year, "only in", "only in", "", strconv.Itoa); phrase != "" {
+		phrases = append(phrases, phrase)
+	}
+
+	description := strings.Join(phrases, ", ")
+	if description == "" {
+		return ""
+	}
+
+	return strings.ToUpper(description[:1]) + description[1:]
+}
+
+func describeTime(sec, minute, hour Resolver) []string {
+	s, secFixed := sec.(resolve.FixedSchedule)
+	m, minFixed := minute.(resolve.FixedSchedule)
+	h, hourFixed := hour.(resolve.FixedSchedule)
+
+	if secFixed && minFixed && hourFixed {
+		return []string{"at " + formatClock(h.At, m.At, s.At)}
+	}
+
+	phrases := make([]string, 0, timeFields)
+
+	switch {
+	case secFixed && s.At == 0:
+		// seconds fixed at zero are implied by the minutes and hours fields
+	case isEverytime(sec):
+		phrases = append(phrases, "every second")
+	default:
+		if phrase := describeField(sec, "at second", "at seconds", "", strconv.Itoa); phrase != "" {
+			phrases = append(phrases, phrase)
+		}
+	}
+
+	switch {
+	case isEverytime(minute) && isEverytime(sec):
+		// every minute is implied by every second
+	case isEverytime(minute) && len(phrases) > 0:
+		phrases = append(phrases, "of every minute")
+	case isEverytime(minute):
+		phrases = append(phrases, "every minute")
+	default:
+		if phrase := describeField(minute, "at minute", "at minutes", "", strconv.Itoa); phrase != "" {
+			phrases = append(phrases, phrase)
+		}
+	}
+
+	switch v := hour.(type) {
+	case resolve.FixedSchedule:
+		phrases = append(phrases, "between "+formatClock(v.At, 0, 0)+" and "+formatClock(v.At, lastMinute, 0))
+	case resolve.RangeSchedule:
+		phrases = append(phrases, "between "+formatClock(v.From, 0, 0)+" and "+formatClock(v.To, lastMinute, 0))
+	case resolve.StepSchedule:
+		phrases = append(phrases, "during the "+joinValues(v.Steps, formatHour)+" hours")
+	}
+
+	return phrases
+}
+
+func describeField(r Resolver, singular, plural, suffix string, name func(int) string) string {
+	var phrase string
+
+	switch v := r.(type) {
+	case resolve.FixedSchedule:
+		phrase = singular + " " + name(v.At)
+	case resolve.RangeSchedule:
+		phrase = name(v.From) + " through " + name(v.To)
+
+		if plural != singular {
+			phrase = plural + " " + phrase
+		}
+	case resolve.StepSchedule:
+		phrase = plural + " " + joinValues(v.Steps, name)
+	case resolve.YearSchedule:
+		phrase = plural + " " + joinValues(v.Years, name)
+	case resolve.FromEndSchedule:
+		// only set in the days of the month
+		return describeFromEnd(v.FromEnd)
+	default:
+		// resolve.Everytime and unset resolvers do not constrain the schedule
+		return ""
+	}
+
+	if suffix != "" {
+		phrase += " " + suffix
+	}
+
+	return phrase
+}
+
+// describeFromEnd describes a day of the month counted back from its last day, such as "on the last day of the month"
+// or "on the 3rd to last day of the month".
+func describeFromEnd(fromEnd int) string {
+	if fromEnd == 1 {
+		return "on the last day of the month"
+	}
+
+	return "on the " + ordinal(fromEnd) + " to last day of the month"
+}
+
+// ordinal returns the input number followed by its English ordinal suffix (e.g. 1st, 2nd, 3rd, 4th, 11th or 22nd).
+//
+//nolint:gomnd // the suffixes depend on the last one or two digits of the number
+func ordinal(n int) string {
+	suffix := "th"
+
+	switch n % 10 {
+	case 1:
+		suffix = "st"
+	case 2:
+		suffix = "nd"
+	case 3:
+		suffix = "rd"
+	}
+
+	if n%100 >= 11 && n%100 <= 13 {
+		suffix = "th"
+	}
+
+	return strconv.Itoa(n) + suffix
+}
+
+func isEverytime(r Resolver) bool {
+	_, ok := r.(resolve.Everytime)
+
+	return ok
+}
+
+func joinValues(values []int, name func(int) string) string {
+	names := make([]string, 0, len(values))
+	seen := make(map[string]struct{}, len(values))
+
+	for i := range values {
+		// skip aliases for the same value, like weekdays 0 and 7 (Sunday)
+		n := name(values[i])
+		if _, ok := seen[n]; ok {
+			continue
+		}
+
+		seen[n] = struct{}{}
+		names = append(names, n)
+	}
+
+	switch len(names) {
+	case 0:
+		return ""
+	case 1:
+		return names[0]
+	case pairOfValues:
+		return names[0] + " and " + names[1]
+	default:
+		return strings.Join(names[:len(names)-1], ", ") + ", and " + names[len(names)-1]
+	}
+}
+
+func formatClock(hour, minute, sec int) string {
+	t := time.Date(0, time.January, 1, hour, minute, sec, 0, time.UTC)
+
+	if sec != 0 {
+		return t.Format(clockFormatWithSec)
+	}
+
+	return t.Format(clockFormat)
+}
+
+func formatHour(hour int) string {
+	return time.Date(0, time.January, 1, hour, 0, 0, 0, time.UTC).Format(hourFormat)
+}
+
+func monthName(month int) string {
+	return time.Month(month).String()
+}
+
+func weekdayName(weekday int) string {
+	// weekdays are indexed as in weekdaysList, where both 0 and 7 are Sunday
+	return time.Weekday(weekday % daysInWeek).String()
+}
@@ -0,0 +1,120 @@
+package cronlex
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/zalgonoise/x/is"
+)
+
+func TestDescribe(t *testing.T) {
+	for _, testcase := range []struct {
+		name  string
+		input string
+		wants string
+		err   error
+	}{
+		{
+			name:  "Success/EveryMinute",
+			input: "* * * * *",
+			wants: "Every minute",
+		},
+		{
+			name:  "Success/EverySecond",
+			input: "* * * * * *",
+			wants: "Every second",
+		},
+		{
+			name:  "Success/Weekdays",
+			input: "0 9 * * 1-5",
+			wants: "At 9:00 AM, Monday through Friday",
+		},
+		{
+			name:  "Success/LastDayOfTheMonth",
+			input: "0 18 -1 * *",
+			wants: "At 6:00 PM, on the last day of the month",
+		},
+		{
+			name:  "Success/SecondToLastDayOfTheMonth",
+			input: "0 18 -2 * *",
+			wants: "At 6:00 PM, on the 2nd to last day of the month",
+		},
+		{
+			name:  "Success/TwelfthToLastDayOfTheMonth",
+			input: "0 18 -12 * *",
+			wants: "At 6:00 PM, on the 12th to last day of the month",
+		},
+		{
+			name:  "Success/WithSeconds",
+			input: "30 15 18 * * *",
+			wants: "At 6:15:30 PM",
+		},
+		{
+			name:  "Success/MinuteStepsWithinHourRange",
+			input: "*/15 9-17 * * *",
+			wants: "At minutes 0, 15, 30, and 45, between 9:00 AM and 5:59 PM",
+		},
+		{
+			name:  "Success/HourSteps",
+			input: "0 0/6 * * *",
+			wants: "At minute 0, during the 12 AM, 6 AM, 12 PM, and 6 PM hours",
+		},
+		{
+			name:  "Success/SecondsOfEveryMinute",
+			input: "0/20 * * * * *",
+			wants: "At seconds 0, 20, and 40, of every minute",
+		},
+		{
+			name:  "Success/DaysOfMonthAndMonths",
+			input: "0 0 1,15 jan-mar *",
+			wants: "At 12:00 AM, on days 1 and 15 of the month, January through March",
+		},
+		{
+			name:  "Success/FixedDayAndMonth",
+			input: "0 12 25 12 *",
+			wants: "At 12:00 PM, on day 25 of the month, only in December",
+		},
+		{
+			name:  "Success/WeekdayNames",
+			input: "0 8 * * sun,wed,7",
+			wants: "At 8:00 AM, only on Sunday and Wednesday",
+		},
+		{
+			name:  "Success/DayOfMonthOrWeek",
+			input: "0 0 13 * 5",
+			wants: "At 12:00 AM, on day 13 of the month or on Friday",
+		},
+		{
+			name:  "Success/DayOfMonthOrWeekRange",
+			input: "0 0 1 * 1-5",
+			wants: "At 12:00 AM, on day 1 of the month or Monday through Friday",
+		},
+		{
+			name:  "Success/WithYear",
+			input: "0 0 0 1 1 * 2025",
+			wants: "At 12:00 AM, on day 1 of the month, only in January, only in 2025",
+		},
+		{
+			name:  "Success/Reboot",
+			input: "@reboot",
+			wants: "At startup",
+		},
+		{
+			name:  "Success/Every",
+			input: "@every 90s",
+			wants: "Every 1m30s",
+		},
+		{
+			name:  "Fail/InvalidCronString",
+			input: "@nope",
+			err:   ErrInvalidFrequency,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			description, err := Describe(testcase.input)
+
+			is.True(t, errors.Is(err, testcase.err))
+			is.Equal(t, testcase.wants, description)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package cronlex
+
+import "github.com/zalgonoise/micron/schedule/resolve"
+
+// Equal returns true if the input Schedule is semantically identical to this one, meaning that both trigger on the
+// same set of times.
+//
+// Each field is compared over its range of values rather than by its Resolver's type, so different expressions of the
+// same occurrences are equal (e.g. `0-59`, `*/1` and `*` in the minutes field). The exception is a day field (of the
+// month or of the week) set as a wildcard: as restricting both day fields matches days on either of them, `*` and
+// `0-6` in the day-of-the-week field are only equal if the day-of-the-month field is also a wildcard.
+func (s Schedule) Equal(other Schedule) bool {
+	if s.Every != other.Every || s.Once != other.Once {
+		return false
+	}
+
+	// restricting both day fields changes how days match (either of them instead of both)
+	if restrictsDays(s) != restrictsDays(other) {
+		return false
+	}
+
+	return equalField(s.Sec, other.Sec, 0, maxSec) &&
+		equalField(s.Min, other.Min, 0, maxMin) &&
+		equalField(s.Hour, other.Hour, 0, maxHour) &&
+		equalDayMonth(s.DayMonth, other.DayMonth) &&
+		equalField(s.Month, other.Month, 1, maxMonth) &&
+		// Sunday is only matched as 0, since extraSunday is its alias
+		equalField(s.DayWeek, other.DayWeek, 0, extraSunday-1) &&
+		equalField(s.Year, other.Year, minYear, maxYear)
+}
+
+// equalField returns true if both Resolver(s) contain the same values, between minimum and maximum. An unset Resolver
+// contains any value.
+func equalField(a, b Resolver, minimum, maximum int) bool {
+	for value := minimum; value <= maximum; value++ {
+		if contains(a, value) != contains(b, value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// equalDayMonth returns true if both day-of-the-month Resolver(s) contain the same days. A resolve.FromEndSchedule
+// depends on the length of the month, so it is only equal to another one counting the same days back from the end.
+func equalDayMonth(a, b Resolver) bool {
+	fromEndA, okA := a.(resolve.FromEndSchedule)
+	fromEndB, okB := b.(resolve.FromEndSchedule)
+
+	switch {
+	case okA && okB:
+		return fromEndA.FromEnd == fromEndB.FromEnd
+	case okA || okB:
+		return false
+	default:
+		return equalField(a, b, 1, maxDay)
+	}
+}
+
+func contains(r Resolver, value int) bool {
+	return r == nil || r.Contains(value)
+}
+
+func restrictsDays(s Schedule) bool {
+	return !isWildcard(s.DayMonth) && !isWildcard(s.DayWeek)
+}
+
+func isWildcard(r Resolver) bool {
+	return r == nil || isEverytime(r)
+}
@@ -0,0 +1,125 @@
+package cronlex
+
+import (
+	"testing"
+
+	"github.com/zalgonoise/x/is"
+
+	"github.com/zalgonoise/micron/schedule/resolve"
+)
+
+func TestSchedule_Equal(t *testing.T) {
+	for _, testcase := range []struct {
+		name  string
+		a     string
+		b     string
+		wants bool
+	}{
+		{
+			name:  "Same",
+			a:     "0 9 * * 1-5",
+			b:     "0 9 * * 1-5",
+			wants: true,
+		},
+		{
+			name:  "SameFromEnd",
+			a:     "0 0 -1 * *",
+			b:     "0 0 -1 * *",
+			wants: true,
+		},
+		{
+			name:  "DifferentFromEnd",
+			a:     "0 0 -1 * *",
+			b:     "0 0 -2 * *",
+			wants: false,
+		},
+		{
+			name:  "FromEndAndFixedDay",
+			a:     "0 0 -1 * *",
+			b:     "0 0 31 * *",
+			wants: false,
+		},
+		{
+			name:  "RangeAndWildcard",
+			a:     "0-59 * * * *",
+			b:     "* * * * *",
+			wants: true,
+		},
+		{
+			name:  "StepsAndRange",
+			a:     "0 9 * * 1,2,3,4,5",
+			b:     "0 9 * * 1-5",
+			wants: true,
+		},
+		{
+			name:  "UnorderedDuplicateSteps",
+			a:     "0 9,12,9 * * *",
+			b:     "0 12,9 * * *",
+			wants: true,
+		},
+		{
+			name:  "SundayAlias",
+			a:     "0 0 * * 7",
+			b:     "0 0 * * 0",
+			wants: true,
+		},
+		{
+			name:  "OverrideAndFields",
+			a:     "@daily",
+			b:     "0 0 * * *",
+			wants: true,
+		},
+		{
+			name:  "ImplicitSeconds",
+			a:     "0 0 * * * *",
+			b:     "0 * * * *",
+			wants: true,
+		},
+		{
+			name: "DifferentMinutes",
+			a:    "0 9 * * *",
+			b:    "1 9 * * *",
+		},
+		{
+			name: "DayWildcardChangesMatching",
+			a:    "0 0 1 * 0-6",
+			b:    "0 0 1 * *",
+		},
+		{
+			name: "DifferentEvery",
+			a:    "@every 1m",
+			b:    "@every 2m",
+		},
+		{
+			name: "RebootAndFields",
+			a:    "@reboot",
+			b:    "* * * * *",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			a, err := Parse(testcase.a)
+			is.Empty(t, err)
+
+			b, err := Parse(testcase.b)
+			is.Empty(t, err)
+
+			is.Equal(t, testcase.wants, a.Equal(b))
+			is.Equal(t, testcase.wants, b.Equal(a))
+		})
+	}
+}
+
+func TestSchedule_Equal_UnsetResolvers(t *testing.T) {
+	unset := Schedule{}
+	wildcards := Schedule{
+		Sec:      resolve.Everytime{},
+		Min:      resolve.Everytime{},
+		Hour:     resolve.Everytime{},
+		DayMonth: resolve.Everytime{},
+		Month:    resolve.Everytime{},
+		DayWeek:  resolve.Everytime{},
+	}
+
+	is.True(t, unset.Equal(wildcards))
+	is.True(t, wildcards.Equal(unset))
+}
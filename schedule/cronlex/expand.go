@@ -0,0 +1,39 @@
+package cronlex
+
+// Expander describes a Resolver that is able to list the complete set of values it resolves on.
+//
+// It is kept apart from Resolver so that existing implementations of that interface remain valid; Expand covers
+// Resolver(s) that do not implement it.
+type Expander interface {
+	// Expand returns the complete set of values that the Resolver resolves on, in ascending order.
+	Expand() []int
+}
+
+// Expand returns the complete set of values that the input Resolver resolves on, between minimum and maximum (both
+// inclusive), in ascending order. It is useful to render a schedule's occurrences per field, like a grid of the minutes
+// and hours that a job runs on.
+//
+// Resolver(s) implementing Expander list their own values, which are then limited to the input bounds. Otherwise, like
+// with resolve.Everytime, each value in the bounds is checked with the Resolver's Contains method. An unset Resolver
+// contains any value.
+func Expand(r Resolver, minimum, maximum int) []int {
+	values := make([]int, 0, maximum-minimum+1)
+
+	if expander, ok := r.(Expander); ok {
+		for _, value := range expander.Expand() {
+			if value >= minimum && value <= maximum {
+				values = append(values, value)
+			}
+		}
+
+		return values
+	}
+
+	for value := minimum; value <= maximum; value++ {
+		if contains(r, value) {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
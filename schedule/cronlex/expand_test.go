@@ -0,0 +1,95 @@
+package cronlex
+
+import (
+	"testing"
+
+	"github.com/zalgonoise/x/is"
+
+	"github.com/zalgonoise/micron/schedule/resolve"
+)
+
+func TestExpand(t *testing.T) {
+	for _, testcase := range []struct {
+		name     string
+		resolver Resolver
+		minimum  int
+		maximum  int
+		wants    []int
+	}{
+		{
+			name:     "Everytime",
+			resolver: resolve.Everytime{},
+			minimum:  1,
+			maximum:  12,
+			wants:    []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+		},
+		{
+			name:    "Unset",
+			minimum: 0,
+			maximum: 6,
+			wants:   []int{0, 1, 2, 3, 4, 5, 6},
+		},
+		{
+			name:     "Fixed",
+			resolver: resolve.FixedSchedule{Max: 59, At: 15},
+			minimum:  0,
+			maximum:  59,
+			wants:    []int{15},
+		},
+		{
+			name:     "Range",
+			resolver: resolve.RangeSchedule{Max: 6, From: 1, To: 5},
+			minimum:  0,
+			maximum:  6,
+			wants:    []int{1, 2, 3, 4, 5},
+		},
+		{
+			name:     "Range/Wrapping",
+			resolver: resolve.RangeSchedule{Max: 23, From: 22, To: 2},
+			minimum:  0,
+			maximum:  23,
+			wants:    []int{0, 1, 2, 22, 23},
+		},
+		{
+			name:     "Step",
+			resolver: resolve.NewStepSchedule(0, 59, 59, 15),
+			minimum:  0,
+			maximum:  59,
+			wants:    []int{0, 15, 30, 45},
+		},
+		{
+			name:     "Step/Unsorted",
+			resolver: resolve.StepSchedule{Max: 59, Steps: []int{45, 0, 15, 0}},
+			minimum:  0,
+			maximum:  59,
+			wants:    []int{0, 15, 45},
+		},
+		{
+			name:     "FromEnd",
+			resolver: resolve.FromEndSchedule{Max: 31, FromEnd: 2},
+			minimum:  1,
+			maximum:  31,
+			wants:    []int{30},
+		},
+		{
+			name:     "Year/Bounded",
+			resolver: resolve.YearSchedule{Years: []int{2030, 2025, 2100}},
+			minimum:  minYear,
+			maximum:  maxYear,
+			wants:    []int{2025, 2030},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			is.EqualElements(t, testcase.wants, Expand(testcase.resolver, testcase.minimum, testcase.maximum))
+		})
+	}
+}
+
+func TestExpand_Schedule(t *testing.T) {
+	s, err := Parse("*/15 9-17 * * *")
+	is.Empty(t, err)
+
+	is.EqualElements(t, []int{0, 15, 30, 45}, Expand(s.Min, 0, maxMin))
+	is.EqualElements(t, []int{9, 10, 11, 12, 13, 14, 15, 16, 17}, Expand(s.Hour, 0, maxHour))
+	is.Equal(t, maxDay, len(Expand(s.DayMonth, 1, maxDay)))
+}
@@ -0,0 +1,148 @@
+package cronlex
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/zalgonoise/micron/schedule/resolve"
+)
+
+type scheduleJSON struct {
+	Sec      json.RawMessage `json:"sec,omitempty"`
+	Min      json.RawMessage `json:"min,omitempty"`
+	Hour     json.RawMessage `json:"hour,omitempty"`
+	DayMonth json.RawMessage `json:"day_month,omitempty"`
+	Month    json.RawMessage `json:"month,omitempty"`
+	DayWeek  json.RawMessage `json:"day_week,omitempty"`
+	Year     json.RawMessage `json:"year,omitempty"`
+
+	Every string `json:"every,omitempty"`
+	Once  bool   `json:"once,omitempty"`
+}
+
+// MarshalJSON encodes the Schedule as JSON, with each of its Resolver elements tagged with their type (like
+// `{"type":"fixed","max":59,"at":0}`), so that the exact same Schedule is decoded with UnmarshalJSON.
+//
+// Unset Resolver elements are omitted, while the Every interval is encoded as a time.Duration string.
+func (s Schedule) MarshalJSON() ([]byte, error) {
+	v := scheduleJSON{Once: s.Once}
+
+	if s.Every > 0 {
+		v.Every = s.Every.String()
+	}
+
+	for _, field := range []struct {
+		resolver Resolver
+		dst      *json.RawMessage
+	}{
+		{s.Sec, &v.Sec},
+		{s.Min, &v.Min},
+		{s.Hour, &v.Hour},
+		{s.DayMonth, &v.DayMonth},
+		{s.Month, &v.Month},
+		{s.DayWeek, &v.DayWeek},
+		{s.Year, &v.Year},
+	} {
+		if field.resolver == nil {
+			continue
+		}
+
+		data, err := json.Marshal(field.resolver)
+		if err != nil {
+			return nil, err
+		}
+
+		*field.dst = data
+	}
+
+	return json.Marshal(v)
+}
+
+// UnmarshalJSON decodes a JSON-encoded Schedule, as produced by MarshalJSON, into the Schedule.
+//
+// It returns a resolve.ErrInvalidType error if any Resolver element has an unknown type tag.
+func (s *Schedule) UnmarshalJSON(data []byte) error {
+	var v scheduleJSON
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	sched := Schedule{Once: v.Once}
+
+	if v.Every != "" {
+		dur, err := time.ParseDuration(v.Every)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidDuration, err)
+		}
+
+		sched.Every = dur
+	}
+
+	for _, field := range []struct {
+		data json.RawMessage
+		dst  *Resolver
+	}{
+		{v.Sec, &sched.Sec},
+		{v.Min, &sched.Min},
+		{v.Hour, &sched.Hour},
+		{v.DayMonth, &sched.DayMonth},
+		{v.Month, &sched.Month},
+		{v.DayWeek, &sched.DayWeek},
+		{v.Year, &sched.Year},
+	} {
+		if len(field.data) == 0 {
+			continue
+		}
+
+		r, err := unmarshalResolver(field.data)
+		if err != nil {
+			return err
+		}
+
+		*field.dst = r
+	}
+
+	*s = sched
+
+	return nil
+}
+
+func unmarshalResolver(data []byte) (Resolver, error) {
+	typ, err := resolve.TypeOf(data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch typ {
+	case resolve.TypeEverytime:
+		return decodeResolver[resolve.Everytime](data)
+	case resolve.TypeFixed:
+		return decodeResolver[resolve.FixedSchedule](data)
+	case resolve.TypeRange:
+		return decodeResolver[resolve.RangeSchedule](data)
+	case resolve.TypeStep:
+		return decodeResolver[resolve.StepSchedule](data)
+	case resolve.TypeYear:
+		return decodeResolver[resolve.YearSchedule](data)
+	case resolve.TypeFromEnd:
+		return decodeResolver[resolve.FromEndSchedule](data)
+	default:
+		return nil, fmt.Errorf("%w: %q", resolve.ErrInvalidType, typ)
+	}
+}
+
+// decodeResolver decodes the JSON-encoded Resolver, returning it by value as the parser does.
+func decodeResolver[T Resolver, P interface {
+	*T
+	json.Unmarshaler
+}](data []byte) (Resolver, error) {
+	var r T
+
+	if err := P(&r).UnmarshalJSON(data); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
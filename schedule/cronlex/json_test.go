@@ -0,0 +1,84 @@
+package cronlex
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zalgonoise/x/is"
+
+	"github.com/zalgonoise/micron/schedule/resolve"
+)
+
+func TestSchedule_JSON(t *testing.T) {
+	for _, testcase := range []struct {
+		name  string
+		input string
+	}{
+		{name: "AllStar", input: "* * * * *"},
+		{name: "AllStarWithSeconds", input: "* * * * * *"},
+		{name: "FixedAndRange", input: "30 9 1-15 * 1-5"},
+		{name: "WrapAroundRange", input: "0 22-2 * * *"},
+		{name: "StepsAndNames", input: "*/15 0 1 jan,jul mon,wed,fri"},
+		{name: "WithYear", input: "0 0 0 1 1 * 2025-2027"},
+		{name: "FromEnd", input: "0 0 -1 * *"},
+		{name: "Override", input: "@daily"},
+		{name: "Reboot", input: "@reboot"},
+		{name: "Every", input: "@every 90s"},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			sched, err := Parse(testcase.input)
+			is.Empty(t, err)
+
+			data, err := json.Marshal(sched)
+			is.Empty(t, err)
+
+			var decoded Schedule
+
+			is.Empty(t, json.Unmarshal(data, &decoded))
+			require.Equal(t, sched, decoded)
+		})
+	}
+}
+
+func TestSchedule_MarshalJSON(t *testing.T) {
+	sched, err := Parse("*/20 9-17 * * 1")
+	is.Empty(t, err)
+
+	data, err := json.Marshal(sched)
+	is.Empty(t, err)
+
+	is.Equal(t, `{"sec":{"type":"fixed","max":59,"at":0},`+
+		`"min":{"type":"step","max":59,"steps":[0,20,40]},`+
+		`"hour":{"type":"range","max":23,"from":9,"to":17},`+
+		`"day_month":{"type":"everytime"},`+
+		`"month":{"type":"everytime"},`+
+		`"day_week":{"type":"fixed","max":7,"at":1}}`, string(data))
+}
+
+func TestSchedule_UnmarshalJSON(t *testing.T) {
+	for _, testcase := range []struct {
+		name  string
+		input string
+		err   error
+	}{
+		{
+			name:  "UnknownType",
+			input: `{"min":{"type":"sometimes"}}`,
+			err:   resolve.ErrInvalidType,
+		},
+		{
+			name:  "InvalidDuration",
+			input: `{"every":"soon"}`,
+			err:   ErrInvalidDuration,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var sched Schedule
+
+			err := json.Unmarshal([]byte(testcase.input), &sched)
+			is.True(t, errors.Is(err, testcase.err))
+		})
+	}
+}
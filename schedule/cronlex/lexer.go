@@ -12,7 +12,8 @@ import (
 // As the lexer scans through each character in the cron string, it emits tokens that are representative on the kind of
 // data at hand, as well as the actual (zero-to-many) bytes that compose that token. E.g. a set of alphanumeric
 // characters emit a TokenAlphaNum Token containing all of those characters, while a "*" emits a TokenStar Token,
-// containing the "*" as value. Of course, a TokenEOF Token would hold no value.
+// containing the "*" as value. Of course, a TokenEOF Token would hold no value. A run of spaces and / or tabs emits a
+// single TokenSpace Token.
 //
 // The ParseFunc will then consume these emitted Token from a channel, and organize its AST appropriately within its
 // own logic.
@@ -38,7 +39,12 @@ func StateFunc(l lex.Lexer[Token, byte]) lex.StateFn[Token, byte] {
 		l.Emit(TokenStar)
 
 		return StateFunc
-	case ' ':
+	case ' ', '\t':
+		// runs of whitespace (like in hand-edited crontabs) are collapsed into a single delimiter
+		for item := l.Cur(); item == ' ' || item == '\t'; item = l.Cur() {
+			l.Next()
+		}
+
 		l.Emit(TokenSpace)
 
 		return StateFunc
@@ -0,0 +1,102 @@
+package cronlex
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+//nolint:gochecknoglobals // immutable set of the built-in overrides' names, which cannot be registered
+var builtinOverrides = map[string]struct{}{
+	"yearly":   {},
+	"annually": {},
+	"monthly":  {},
+	"weekly":   {},
+	"daily":    {},
+	"hourly":   {},
+	"minutely": {},
+	"secondly": {},
+	"reboot":   {},
+	"every":    {},
+}
+
+//nolint:gochecknoglobals // process-wide registry of custom overrides, guarded by its mutex
+var overrides = &overrideRegistry{
+	expressions: make(map[string]string),
+}
+
+type overrideRegistry struct {
+	mu          sync.RWMutex
+	expressions map[string]string
+}
+
+// RegisterOverride registers a custom named override, so that cron strings in the form of `@<name>` are parsed as the
+// input cron expression (e.g. registering `mycompany-nightly` as `30 2 * * *` makes `@mycompany-nightly` trigger at
+// 2:30 AM every day). This allows reusing the same conventions across all parsed cron strings in the process.
+//
+// Override names are case-insensitive, may be prefixed with `@`, and are made of letters, digits, dashes and
+// underscores. Registering an existing custom override replaces its expression, while the names of the built-in
+// overrides (like `daily` or `every`) are reserved, returning an ErrReservedOverride error.
+//
+// The input cron expression is validated with Parse, returning its error if raised. It may reference another
+// override, which is resolved when registering.
+//
+// This call is safe for concurrent use, including with calls parsing cron strings.
+func RegisterOverride(name, cron string) error {
+	key := strings.ToLower(strings.TrimPrefix(name, "@"))
+
+	if err := validateOverrideName(key); err != nil {
+		return err
+	}
+
+	if _, ok := builtinOverrides[key]; ok {
+		return fmt.Errorf("%w: @%s", ErrReservedOverride, key)
+	}
+
+	// resolving the expression when registering keeps overrides from referencing each other in a loop
+	cron = expandOverride(cron)
+
+	if _, err := Parse(cron); err != nil {
+		return err
+	}
+
+	overrides.mu.Lock()
+	overrides.expressions[key] = cron
+	overrides.mu.Unlock()
+
+	return nil
+}
+
+func validateOverrideName(name string) error {
+	if name == "" {
+		return fmt.Errorf("%w: empty name", ErrInvalidOverride)
+	}
+
+	for i := range name {
+		switch c := name[i]; {
+		case c >= 'a' && c <= 'z', c >= '0' && c <= '9', c == '-', c == '_':
+		default:
+			return fmt.Errorf("%w: invalid character %q at offset %d", ErrInvalidOverride, c, i)
+		}
+	}
+
+	return nil
+}
+
+// expandOverride replaces a registered custom override in the input cron string with its expression. Any other cron
+// string is returned as-is.
+func expandOverride(cron string) string {
+	name, ok := strings.CutPrefix(strings.TrimSpace(cron), "@")
+	if !ok || strings.ContainsAny(name, " \t") {
+		return cron
+	}
+
+	overrides.mu.RLock()
+	defer overrides.mu.RUnlock()
+
+	if expression, ok := overrides.expressions[strings.ToLower(name)]; ok {
+		return expression
+	}
+
+	return cron
+}
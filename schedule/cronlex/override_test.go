@@ -0,0 +1,126 @@
+package cronlex
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/zalgonoise/x/is"
+)
+
+func unregisterOverrides(t *testing.T, names ...string) {
+	t.Helper()
+
+	t.Cleanup(func() {
+		overrides.mu.Lock()
+		defer overrides.mu.Unlock()
+
+		for i := range names {
+			delete(overrides.expressions, names[i])
+		}
+	})
+}
+
+func TestRegisterOverride(t *testing.T) {
+	unregisterOverrides(t, "mycompany-nightly", "nightly_alias", "top-of-hour")
+
+	for _, testcase := range []struct {
+		name      string
+		override  string
+		cron      string
+		input     string
+		wants     string
+		err       error
+		parseErr  error
+		strictErr error
+	}{
+		{
+			name:     "Success",
+			override: "mycompany-nightly",
+			cron:     "30 2 * * *",
+			input:    "@mycompany-nightly",
+			wants:    "30 2 * * *",
+		},
+		{
+			name:     "Success/CaseInsensitive",
+			override: "@Top-Of-Hour",
+			cron:     "17 * * * *",
+			input:    "@TOP-of-hour",
+			wants:    "17 * * * *",
+		},
+		{
+			name:     "Success/ReferencesOverride",
+			override: "nightly_alias",
+			cron:     "@mycompany-nightly",
+			input:    "  @nightly_alias  ",
+			wants:    "30 2 * * *",
+		},
+		{
+			name:     "Fail/BuiltIn",
+			override: "Daily",
+			cron:     "30 2 * * *",
+			err:      ErrReservedOverride,
+		},
+		{
+			name:     "Fail/BuiltInEvery",
+			override: "@every",
+			cron:     "30 2 * * *",
+			err:      ErrReservedOverride,
+		},
+		{
+			name:     "Fail/EmptyName",
+			override: "@",
+			cron:     "30 2 * * *",
+			err:      ErrInvalidOverride,
+		},
+		{
+			name:     "Fail/InvalidName",
+			override: "my nightly",
+			cron:     "30 2 * * *",
+			err:      ErrInvalidOverride,
+		},
+		{
+			name:     "Fail/InvalidExpression",
+			override: "broken",
+			cron:     "61 * * * *",
+			err:      ErrOutOfBoundsAlphanum,
+			input:    "@broken",
+			parseErr: ErrInvalidFrequency,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			err := RegisterOverride(testcase.override, testcase.cron)
+			if testcase.err != nil {
+				is.True(t, errors.Is(err, testcase.err))
+
+				if testcase.input != "" {
+					_, err = Parse(testcase.input)
+					is.True(t, errors.Is(err, testcase.parseErr))
+				}
+
+				return
+			}
+
+			is.Empty(t, err)
+
+			s, err := Parse(testcase.input)
+			is.Empty(t, err)
+			is.Equal(t, testcase.wants, s.String())
+
+			wants, err := Parse(testcase.wants)
+			is.Empty(t, err)
+			is.True(t, wants.Equal(s))
+		})
+	}
+}
+
+func TestRegisterOverride_Strict(t *testing.T) {
+	unregisterOverrides(t, "every-second")
+
+	is.Empty(t, RegisterOverride("every-second", "* * * * * *"))
+
+	_, err := Parse("@every-second")
+	is.Empty(t, err)
+
+	_, err = ParseStrict("@every-second")
+	is.True(t, errors.Is(err, ErrUnsupportedSeconds))
+}
@@ -15,6 +15,11 @@ import (
 // nodes in the cron string ("* * * * *" means there are 5 top-level child nodes; "@weekly" means there is 1 top-level
 // child node). If a given top-level child node contains more information than a single value (e.g. ranges, sets), then
 // the top-level child node will be the parent to more nodes containing any Token chained to that top-level child node.
+//
+// A TokenDash at the start of a field (e.g. `-1`) denotes a value counted back from the end of its range, while a
+// TokenDash following a value (e.g. `5-10`) denotes a range. The former is a top-level child node of type TokenDash,
+// with the value as its single child node. Such a value only stands by itself in its field, so a TokenDash following a
+// TokenComma (e.g. `1,-1`) is marked as a TokenError.
 func ParseFunc(t *parse.Tree[Token, byte]) parse.ParseFn[Token, byte] {
 	switch t.Peek().Type {
 	case TokenAt:
@@ -23,6 +28,13 @@ func ParseFunc(t *parse.Tree[Token, byte]) parse.ParseFn[Token, byte] {
 		return parseStar
 	case TokenAlphaNum:
 		return parseAlphanum
+	case TokenDash:
+		return parseFromEnd
+	case TokenSpace:
+		// skip leading whitespace
+		t.Next()
+
+		return ParseFunc
 	case TokenEOF:
 		return nil
 	default:
@@ -64,6 +76,47 @@ func parseStar(t *parse.Tree[Token, byte]) parse.ParseFn[Token, byte] {
 	}
 }
 
+// parseFromEnd parses a field starting with a TokenDash, like `-1`. It only supports a single value, so any symbols
+// following it (e.g. `-1,15`) are marked as a TokenError.
+func parseFromEnd(t *parse.Tree[Token, byte]) parse.ParseFn[Token, byte] {
+	t.Node(t.Next())
+
+	if t.Peek().Type == TokenAlphaNum {
+		t.Node(t.Next())
+		//nolint:errcheck // call will not return a meaningful error; tree is still validated in the end
+		_ = t.Set(t.Parent())
+	}
+
+	//nolint:exhaustive // no need to check on all token types
+	switch t.Peek().Type {
+	case TokenSpace:
+		//nolint:errcheck // call will not return a meaningful error; tree is still validated in the end
+		_ = t.Set(t.Parent())
+		t.Next()
+
+		return ParseFunc
+	case TokenEOF:
+		//nolint:errcheck // call will not return a meaningful error; tree is still validated in the end
+		_ = t.Set(t.Parent())
+
+		return nil
+	default:
+		item := t.Next()
+		item.Type = TokenError
+		t.Node(item)
+
+		// skip the remainder of the field, so that the following fields are still parsed as top-level nodes
+		for typ := t.Peek().Type; typ != TokenSpace && typ != TokenEOF; typ = t.Peek().Type {
+			t.Next()
+		}
+
+		//nolint:errcheck // call will not return a meaningful error; tree is still validated in the end
+		_ = t.Set(t.Parent().Parent)
+
+		return ParseFunc
+	}
+}
+
 func parseAlphanumSymbols(t *parse.Tree[Token, byte]) parse.ParseFn[Token, byte] {
 	t.Node(t.Next())
 
@@ -76,9 +129,24 @@ func parseAlphanumSymbols(t *parse.Tree[Token, byte]) parse.ParseFn[Token, byte]
 		return parseAlphanum
 	default:
 		item := t.Next()
+		fromEnd := t.Cur().Type == TokenComma && item.Type == TokenDash
+
 		item.Type = TokenError
 		t.Node(item)
 
+		if !fromEnd {
+			return ParseFunc
+		}
+
+		// a value counted back from the end (e.g. `1,-1`) is not supported in a list: skip the remainder of the field, so
+		// that the following fields are still parsed as top-level nodes
+		for typ := t.Peek().Type; typ != TokenSpace && typ != TokenEOF; typ = t.Peek().Type {
+			t.Next()
+		}
+
+		//nolint:errcheck // call will not return a meaningful error; tree is still validated in the end
+		_ = t.Set(t.Root)
+
 		return ParseFunc
 	}
 }
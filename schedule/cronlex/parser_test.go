@@ -3,6 +3,7 @@ package cronlex
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/zalgonoise/x/is"
@@ -144,6 +145,96 @@ func TestParser(t *testing.T) {
 				DayWeek:  resolve.Everytime{},
 			},
 		},
+		{
+			name:  "Success/Simple/SteppedRange",
+			input: "0-10/2 * * * *",
+			wants: Schedule{
+				Sec: resolve.FixedSchedule{Max: 59, At: 0},
+				Min: resolve.StepSchedule{
+					Max:   59,
+					Steps: []int{0, 2, 4, 6, 8, 10},
+				},
+				Hour:     resolve.Everytime{},
+				DayMonth: resolve.Everytime{},
+				Month:    resolve.Everytime{},
+				DayWeek:  resolve.Everytime{},
+			},
+		},
+		{
+			name:  "Success/Simple/MultipleSteppedRanges",
+			input: "0-10/2,20-30/3 * * * *",
+			wants: Schedule{
+				Sec: resolve.FixedSchedule{Max: 59, At: 0},
+				Min: resolve.StepSchedule{
+					Max:   59,
+					Steps: []int{0, 2, 4, 6, 8, 10, 20, 23, 26, 29},
+				},
+				Hour:     resolve.Everytime{},
+				DayMonth: resolve.Everytime{},
+				Month:    resolve.Everytime{},
+				DayWeek:  resolve.Everytime{},
+			},
+		},
+		{
+			name:  "Success/Simple/SteppedRangeWithValuesAndRanges",
+			input: "45,0-10/5,15-17 * * * *",
+			wants: Schedule{
+				Sec: resolve.FixedSchedule{Max: 59, At: 0},
+				Min: resolve.StepSchedule{
+					Max:   59,
+					Steps: []int{0, 5, 10, 15, 16, 17, 45},
+				},
+				Hour:     resolve.Everytime{},
+				DayMonth: resolve.Everytime{},
+				Month:    resolve.Everytime{},
+				DayWeek:  resolve.Everytime{},
+			},
+		},
+		{
+			name:  "Success/Simple/SteppedRangeAndFrequency",
+			input: "0-4/2,50/5 * * * *",
+			wants: Schedule{
+				Sec: resolve.FixedSchedule{Max: 59, At: 0},
+				Min: resolve.StepSchedule{
+					Max:   59,
+					Steps: []int{0, 2, 4, 50, 55},
+				},
+				Hour:     resolve.Everytime{},
+				DayMonth: resolve.Everytime{},
+				Month:    resolve.Everytime{},
+				DayWeek:  resolve.Everytime{},
+			},
+		},
+		{
+			name:  "Success/Simple/SteppedWrapAroundHourRange",
+			input: "0 22-2/2 * * *",
+			wants: Schedule{
+				Sec: resolve.FixedSchedule{Max: 59, At: 0},
+				Min: resolve.FixedSchedule{Max: 59, At: 0},
+				Hour: resolve.StepSchedule{
+					Max:   23,
+					Steps: []int{0, 2, 22},
+				},
+				DayMonth: resolve.Everytime{},
+				Month:    resolve.Everytime{},
+				DayWeek:  resolve.Everytime{},
+			},
+		},
+		{
+			name:  "Success/Simple/SteppedWeekdayStringRange",
+			input: "0 0 * * MON-FRI/2",
+			wants: Schedule{
+				Sec:      resolve.FixedSchedule{Max: 59, At: 0},
+				Min:      resolve.FixedSchedule{Max: 59, At: 0},
+				Hour:     resolve.FixedSchedule{Max: 23, At: 0},
+				DayMonth: resolve.Everytime{},
+				Month:    resolve.Everytime{},
+				DayWeek: resolve.StepSchedule{
+					Max:   7,
+					Steps: []int{1, 3, 5},
+				},
+			},
+		},
 		{
 			name: "Success/Simple/EveryMinuteLiteral",
 			//nolint:lll // long string literals
@@ -205,6 +296,214 @@ func TestParser(t *testing.T) {
 				DayWeek: resolve.Everytime{},
 			},
 		},
+		{
+			name:  "Success/Simple/WrapAroundHourRange",
+			input: "0 22-2 * * *",
+			wants: Schedule{
+				Sec: resolve.FixedSchedule{Max: 59, At: 0},
+				Min: resolve.FixedSchedule{
+					Max: 59,
+					At:  0,
+				},
+				Hour: resolve.RangeSchedule{
+					Max:  23,
+					From: 22,
+					To:   2,
+				},
+				DayMonth: resolve.Everytime{},
+				Month:    resolve.Everytime{},
+				DayWeek:  resolve.Everytime{},
+			},
+		},
+		{
+			name:  "Success/Simple/WrapAroundMonthRangeWithValues",
+			input: "0 0 1 11-2,6 *",
+			wants: Schedule{
+				Sec: resolve.FixedSchedule{Max: 59, At: 0},
+				Min: resolve.FixedSchedule{
+					Max: 59,
+					At:  0,
+				},
+				Hour: resolve.FixedSchedule{
+					Max: 23,
+					At:  0,
+				},
+				DayMonth: resolve.FixedSchedule{
+					Max: 31,
+					At:  1,
+				},
+				Month: resolve.StepSchedule{
+					Max:   12,
+					Steps: []int{1, 2, 6, 11, 12},
+				},
+				DayWeek: resolve.Everytime{},
+			},
+		},
+		{
+			name:  "Success/Simple/SundayAsSeven",
+			input: "0 0 * * 7",
+			wants: Schedule{
+				Sec:      resolve.FixedSchedule{Max: 59, At: 0},
+				Min:      resolve.FixedSchedule{Max: 59, At: 0},
+				Hour:     resolve.FixedSchedule{Max: 23, At: 0},
+				DayMonth: resolve.Everytime{},
+				Month:    resolve.Everytime{},
+				DayWeek:  resolve.FixedSchedule{Max: 7, At: 0},
+			},
+		},
+		{
+			name:  "Success/Simple/RangeEndingOnSunday",
+			input: "0 0 * * 5-7",
+			wants: Schedule{
+				Sec:      resolve.FixedSchedule{Max: 59, At: 0},
+				Min:      resolve.FixedSchedule{Max: 59, At: 0},
+				Hour:     resolve.FixedSchedule{Max: 23, At: 0},
+				DayMonth: resolve.Everytime{},
+				Month:    resolve.Everytime{},
+				DayWeek:  resolve.RangeSchedule{Max: 7, From: 5, To: 0},
+			},
+		},
+		{
+			name:  "Success/Simple/RangeStartingOnSunday",
+			input: "0 0 * * 7-2",
+			wants: Schedule{
+				Sec:      resolve.FixedSchedule{Max: 59, At: 0},
+				Min:      resolve.FixedSchedule{Max: 59, At: 0},
+				Hour:     resolve.FixedSchedule{Max: 23, At: 0},
+				DayMonth: resolve.Everytime{},
+				Month:    resolve.Everytime{},
+				DayWeek:  resolve.RangeSchedule{Max: 7, From: 0, To: 2},
+			},
+		},
+		{
+			name:  "Success/Simple/EntireWeekRange",
+			input: "0 0 * * 0-7",
+			wants: Schedule{
+				Sec:      resolve.FixedSchedule{Max: 59, At: 0},
+				Min:      resolve.FixedSchedule{Max: 59, At: 0},
+				Hour:     resolve.FixedSchedule{Max: 23, At: 0},
+				DayMonth: resolve.Everytime{},
+				Month:    resolve.Everytime{},
+				DayWeek:  resolve.RangeSchedule{Max: 7, From: 0, To: 6},
+			},
+		},
+		{
+			name:  "Success/Simple/WeekdayStringRange",
+			input: "0 0 * * MON-FRI",
+			wants: Schedule{
+				Sec:      resolve.FixedSchedule{Max: 59, At: 0},
+				Min:      resolve.FixedSchedule{Max: 59, At: 0},
+				Hour:     resolve.FixedSchedule{Max: 23, At: 0},
+				DayMonth: resolve.Everytime{},
+				Month:    resolve.Everytime{},
+				DayWeek:  resolve.RangeSchedule{Max: 7, From: 1, To: 5},
+			},
+		},
+		{
+			name:  "Success/Simple/WeekdayStringRangeLowercase",
+			input: "0 0 * * mon-fri",
+			wants: Schedule{
+				Sec:      resolve.FixedSchedule{Max: 59, At: 0},
+				Min:      resolve.FixedSchedule{Max: 59, At: 0},
+				Hour:     resolve.FixedSchedule{Max: 23, At: 0},
+				DayMonth: resolve.Everytime{},
+				Month:    resolve.Everytime{},
+				DayWeek:  resolve.RangeSchedule{Max: 7, From: 1, To: 5},
+			},
+		},
+		{
+			name:  "Success/Simple/StringRangeEndingOnSunday",
+			input: "0 0 * * FRI-SUN",
+			wants: Schedule{
+				Sec:      resolve.FixedSchedule{Max: 59, At: 0},
+				Min:      resolve.FixedSchedule{Max: 59, At: 0},
+				Hour:     resolve.FixedSchedule{Max: 23, At: 0},
+				DayMonth: resolve.Everytime{},
+				Month:    resolve.Everytime{},
+				DayWeek:  resolve.RangeSchedule{Max: 7, From: 5, To: 0},
+			},
+		},
+		{
+			name:  "Success/Simple/MixedWeekdayRange",
+			input: "0 0 * * 1-FRI",
+			wants: Schedule{
+				Sec:      resolve.FixedSchedule{Max: 59, At: 0},
+				Min:      resolve.FixedSchedule{Max: 59, At: 0},
+				Hour:     resolve.FixedSchedule{Max: 23, At: 0},
+				DayMonth: resolve.Everytime{},
+				Month:    resolve.Everytime{},
+				DayWeek:  resolve.RangeSchedule{Max: 7, From: 1, To: 5},
+			},
+		},
+		{
+			name:  "Success/Simple/MonthStringRange",
+			input: "0 0 1 JAN-MAR *",
+			wants: Schedule{
+				Sec:      resolve.FixedSchedule{Max: 59, At: 0},
+				Min:      resolve.FixedSchedule{Max: 59, At: 0},
+				Hour:     resolve.FixedSchedule{Max: 23, At: 0},
+				DayMonth: resolve.FixedSchedule{Max: 31, At: 1},
+				Month:    resolve.RangeSchedule{Max: 12, From: 1, To: 3},
+				DayWeek:  resolve.Everytime{},
+			},
+		},
+		{
+			name:  "Success/Simple/WrapAroundMonthStringRangeWithValues",
+			input: "0 0 1 NOV-FEB,JUN *",
+			wants: Schedule{
+				Sec:      resolve.FixedSchedule{Max: 59, At: 0},
+				Min:      resolve.FixedSchedule{Max: 59, At: 0},
+				Hour:     resolve.FixedSchedule{Max: 23, At: 0},
+				DayMonth: resolve.FixedSchedule{Max: 31, At: 1},
+				Month: resolve.StepSchedule{
+					Max:   12,
+					Steps: []int{1, 2, 6, 11, 12},
+				},
+				DayWeek: resolve.Everytime{},
+			},
+		},
+		{
+			name:  "Success/Simple/SpelledOutMonthRange",
+			input: "0 0 1 January-MARCH *",
+			wants: Schedule{
+				Sec:      resolve.FixedSchedule{Max: 59, At: 0},
+				Min:      resolve.FixedSchedule{Max: 59, At: 0},
+				Hour:     resolve.FixedSchedule{Max: 23, At: 0},
+				DayMonth: resolve.FixedSchedule{Max: 31, At: 1},
+				Month:    resolve.RangeSchedule{Max: 12, From: 1, To: 3},
+				DayWeek:  resolve.Everytime{},
+			},
+		},
+		{
+			name:  "Success/Simple/MixedSpelledOutAndAbbreviatedMonths",
+			input: "0 0 1 september,Dec,may,JUNE *",
+			wants: Schedule{
+				Sec:      resolve.FixedSchedule{Max: 59, At: 0},
+				Min:      resolve.FixedSchedule{Max: 59, At: 0},
+				Hour:     resolve.FixedSchedule{Max: 23, At: 0},
+				DayMonth: resolve.FixedSchedule{Max: 31, At: 1},
+				Month: resolve.StepSchedule{
+					Max:   12,
+					Steps: []int{5, 6, 9, 12},
+				},
+				DayWeek: resolve.Everytime{},
+			},
+		},
+		{
+			name:  "Success/Simple/MixedSpelledOutAndAbbreviatedWeekdays",
+			input: "0 0 * * Monday-wed,FRIDAY,sun",
+			wants: Schedule{
+				Sec:      resolve.FixedSchedule{Max: 59, At: 0},
+				Min:      resolve.FixedSchedule{Max: 59, At: 0},
+				Hour:     resolve.FixedSchedule{Max: 23, At: 0},
+				DayMonth: resolve.Everytime{},
+				Month:    resolve.Everytime{},
+				DayWeek: resolve.StepSchedule{
+					Max:   7,
+					Steps: []int{0, 1, 2, 3, 5},
+				},
+			},
+		},
 		{
 			name:  "Success/Simple/EveryMonthNumericLiteral",
 			input: "0 0 1 1,2,3,4,5,6,7,8,9,10,11,12 *",
@@ -332,14 +631,84 @@ func TestParser(t *testing.T) {
 			},
 		},
 		{
-			name:  "Success/Overrides/reboot",
-			input: "@reboot",
+			name:  "Success/Whitespace/DoubleSpaces",
+			input: "*  *  *  *  *",
+			wants: Schedule{
+				Sec:      resolve.FixedSchedule{Max: 59, At: 0},
+				Min:      resolve.Everytime{},
+				Hour:     resolve.Everytime{},
+				DayMonth: resolve.Everytime{},
+				Month:    resolve.Everytime{},
+				DayWeek:  resolve.Everytime{},
+			},
+		},
+		{
+			name:  "Success/Whitespace/Tabs",
+			input: "0\t9\t*\t*\tMON-FRI",
+			wants: Schedule{
+				Sec:      resolve.FixedSchedule{Max: 59, At: 0},
+				Min:      resolve.FixedSchedule{Max: 59, At: 0},
+				Hour:     resolve.FixedSchedule{Max: 23, At: 9},
+				DayMonth: resolve.Everytime{},
+				Month:    resolve.Everytime{},
+				DayWeek:  resolve.RangeSchedule{Max: 7, From: 1, To: 5},
+			},
+		},
+		{
+			name:  "Success/Whitespace/MixedAndPadded",
+			input: " \t0/15 \t9-17,20\t\t* *  *  ",
 			wants: Schedule{
 				Sec: resolve.FixedSchedule{Max: 59, At: 0},
-				Min: resolve.FixedSchedule{
-					Max: 59,
-					At:  0,
+				Min: resolve.StepSchedule{Max: 59, Steps: []int{0, 15, 30, 45}},
+				Hour: resolve.StepSchedule{
+					Max:   23,
+					Steps: []int{9, 10, 11, 12, 13, 14, 15, 16, 17, 20},
 				},
+				DayMonth: resolve.Everytime{},
+				Month:    resolve.Everytime{},
+				DayWeek:  resolve.Everytime{},
+			},
+		},
+		{
+			name:  "Success/Whitespace/Every",
+			input: "@every\t  90s",
+			wants: Schedule{Every: 90 * time.Second},
+		},
+		{
+			name:  "Success/Overrides/reboot",
+			input: "@reboot",
+			wants: Schedule{Once: true},
+		},
+		{
+			name:  "Success/Overrides/secondly",
+			input: "@secondly",
+			wants: Schedule{
+				Sec:      resolve.Everytime{},
+				Min:      resolve.Everytime{},
+				Hour:     resolve.Everytime{},
+				DayMonth: resolve.Everytime{},
+				Month:    resolve.Everytime{},
+				DayWeek:  resolve.Everytime{},
+			},
+		},
+		{
+			name:  "Success/Overrides/minutely",
+			input: "@minutely",
+			wants: Schedule{
+				Sec:      resolve.FixedSchedule{Max: 59, At: 0},
+				Min:      resolve.Everytime{},
+				Hour:     resolve.Everytime{},
+				DayMonth: resolve.Everytime{},
+				Month:    resolve.Everytime{},
+				DayWeek:  resolve.Everytime{},
+			},
+		},
+		{
+			name:  "Success/Overrides/minutelyUppercase",
+			input: "@MINUTELY",
+			wants: Schedule{
+				Sec:      resolve.FixedSchedule{Max: 59, At: 0},
+				Min:      resolve.Everytime{},
 				Hour:     resolve.Everytime{},
 				DayMonth: resolve.Everytime{},
 				Month:    resolve.Everytime{},
@@ -469,15 +838,166 @@ func TestParser(t *testing.T) {
 				DayWeek: resolve.Everytime{},
 			},
 		},
+		{
+			name:  "Success/WithYear/Fixed",
+			input: "0 0 0 1 1 * 2025",
+			wants: Schedule{
+				Sec:      resolve.FixedSchedule{Max: 59, At: 0},
+				Min:      resolve.FixedSchedule{Max: 59, At: 0},
+				Hour:     resolve.FixedSchedule{Max: 23, At: 0},
+				DayMonth: resolve.FixedSchedule{Max: 31, At: 1},
+				Month:    resolve.FixedSchedule{Max: 12, At: 1},
+				DayWeek:  resolve.Everytime{},
+				Year:     resolve.YearSchedule{Years: []int{2025}},
+			},
+		},
+		{
+			name:  "Success/WithYear/Range",
+			input: "0 0 0 1 1 * 2025-2027",
+			wants: Schedule{
+				Sec:      resolve.FixedSchedule{Max: 59, At: 0},
+				Min:      resolve.FixedSchedule{Max: 59, At: 0},
+				Hour:     resolve.FixedSchedule{Max: 23, At: 0},
+				DayMonth: resolve.FixedSchedule{Max: 31, At: 1},
+				Month:    resolve.FixedSchedule{Max: 12, At: 1},
+				DayWeek:  resolve.Everytime{},
+				Year:     resolve.YearSchedule{Years: []int{2025, 2026, 2027}},
+			},
+		},
+		{
+			name:  "Success/WithYear/Star",
+			input: "* * * * * * *",
+			wants: Schedule{
+				Sec:      resolve.Everytime{},
+				Min:      resolve.Everytime{},
+				Hour:     resolve.Everytime{},
+				DayMonth: resolve.Everytime{},
+				Month:    resolve.Everytime{},
+				DayWeek:  resolve.Everytime{},
+				Year:     resolve.Everytime{},
+			},
+		},
+		{
+			name:  "Success/WithYear/Step",
+			input: "0 0 0 1 1 * 2090/3",
+			wants: Schedule{
+				Sec:      resolve.FixedSchedule{Max: 59, At: 0},
+				Min:      resolve.FixedSchedule{Max: 59, At: 0},
+				Hour:     resolve.FixedSchedule{Max: 23, At: 0},
+				DayMonth: resolve.FixedSchedule{Max: 31, At: 1},
+				Month:    resolve.FixedSchedule{Max: 12, At: 1},
+				DayWeek:  resolve.Everytime{},
+				Year:     resolve.YearSchedule{Years: []int{2090, 2093, 2096, 2099}},
+			},
+		},
+		{
+			name:  "Success/WithYear/RangeStep",
+			input: "0 0 0 1 1 * 2030-2036/2",
+			wants: Schedule{
+				Sec:      resolve.FixedSchedule{Max: 59, At: 0},
+				Min:      resolve.FixedSchedule{Max: 59, At: 0},
+				Hour:     resolve.FixedSchedule{Max: 23, At: 0},
+				DayMonth: resolve.FixedSchedule{Max: 31, At: 1},
+				Month:    resolve.FixedSchedule{Max: 12, At: 1},
+				DayWeek:  resolve.Everytime{},
+				Year:     resolve.YearSchedule{Years: []int{2030, 2032, 2034, 2036}},
+			},
+		},
+		{
+			name:  "Fail/WithYear/OutOfBounds",
+			input: "0 0 0 1 1 * 2025-2100",
+			wants: Schedule{},
+			err:   ErrOutOfBoundsAlphanum,
+		},
+		{
+			name:  "Fail/WithYear/InvertedRange",
+			input: "0 0 0 1 1 * 2030-2025",
+			wants: Schedule{},
+			err:   ErrInvalidRange,
+		},
+		{
+			name:  "Fail/WithYear/InvertedRangeInList",
+			input: "0 0 0 1 1 * 2025,2030-2026",
+			wants: Schedule{},
+			err:   ErrInvalidRange,
+		},
+		{
+			name:  "Fail/WithYear/ZeroStep",
+			input: "0 0 0 1 1 * 2030/0",
+			wants: Schedule{},
+			err:   ErrInvalidFrequency,
+		},
+		{
+			name:  "Success/Overrides/every",
+			input: "@every 90s",
+			wants: Schedule{
+				Every: 90 * time.Second,
+			},
+		},
+		{
+			name:  "Success/Overrides/everyCompound",
+			input: "@every 2h30m",
+			wants: Schedule{
+				Every: 2*time.Hour + 30*time.Minute,
+			},
+		},
+		{
+			name:  "Fail/Overrides/everyTooShort",
+			input: "@every 500ms",
+			wants: Schedule{},
+			err:   ErrOutOfBoundsDuration,
+		},
+		{
+			name:  "Fail/Overrides/everyMalformed",
+			input: "@every 90",
+			wants: Schedule{},
+			err:   ErrInvalidDuration,
+		},
+		{
+			name:  "Fail/Overrides/everyNoDuration",
+			input: "@every",
+			wants: Schedule{},
+			err:   ErrInvalidNumEdges,
+		},
 		{
 			name:  "Fail/InvalidMonth",
 			input: "* * * jan,jen,jin *",
 			wants: Schedule{},
 			err:   ErrInvalidAlphanum,
 		},
+		{
+			name:  "Fail/InvalidWeekdayRangeStart",
+			input: "0 0 * * FOO-FRI",
+			wants: Schedule{},
+			err:   ErrInvalidAlphanum,
+		},
+		{
+			name:  "Fail/InvalidWeekdayRangeEnd",
+			input: "0 0 * * MON-FOO",
+			wants: Schedule{},
+			err:   ErrInvalidAlphanum,
+		},
+		{
+			name:  "Fail/PartiallySpelledOutWeekday",
+			input: "0 0 * * MOND",
+			wants: Schedule{},
+			err:   ErrInvalidAlphanum,
+		},
+		{
+			name:  "Fail/SpelledOutMonthInWeekdayField",
+			input: "0 0 * * JANUARY",
+			wants: Schedule{},
+			err:   ErrInvalidAlphanum,
+		},
+		{
+			name:  "Fail/StringRangeInNumericField",
+			input: "0 MON-FRI * * *",
+			wants: Schedule{},
+			err:   ErrUnsupportedAlphanum,
+		},
 		{
 			name:  "Fail/TooManyTokens",
-			input: "* * * * * * *",
+			input: "* * * * * * * *",
 			wants: Schedule{},
 			err:   ErrInvalidNumNodes,
 		},
@@ -523,6 +1043,30 @@ func TestParser(t *testing.T) {
 			wants: Schedule{},
 			err:   ErrInvalidNodeType,
 		},
+		{
+			name:  "Fail/ZeroStep/Star",
+			input: "*/0 * * * *",
+			wants: Schedule{},
+			err:   ErrInvalidFrequency,
+		},
+		{
+			name:  "Fail/ZeroStep/Value",
+			input: "5/0 * * * *",
+			wants: Schedule{},
+			err:   ErrInvalidFrequency,
+		},
+		{
+			name:  "Fail/ZeroStep/Padded",
+			input: "0 0 * * */00",
+			wants: Schedule{},
+			err:   ErrInvalidFrequency,
+		},
+		{
+			name:  "Fail/AlphanumericStep",
+			input: "0 0 * * 1/MON",
+			wants: Schedule{},
+			err:   ErrUnsupportedAlphanum,
+		},
 		{
 			name:  "Fail/OutOfBounds",
 			input: "0/64 * * * *",
@@ -547,12 +1091,531 @@ func TestParser(t *testing.T) {
 			wants: Schedule{},
 			err:   ErrInvalidCharacter,
 		},
+		{
+			name:  "Success/FromEnd/LastDay",
+			input: "0 0 -1 * *",
+			wants: Schedule{
+				Sec:      resolve.FixedSchedule{Max: 59, At: 0},
+				Min:      resolve.FixedSchedule{Max: 59, At: 0},
+				Hour:     resolve.FixedSchedule{Max: 23, At: 0},
+				DayMonth: resolve.FromEndSchedule{Max: 31, FromEnd: 1},
+				Month:    resolve.Everytime{},
+				DayWeek:  resolve.Everytime{},
+			},
+		},
+		{
+			name:  "Success/FromEnd/SecondToLastDayWithSeconds",
+			input: "30 0 12 -2 * *",
+			wants: Schedule{
+				Sec:      resolve.FixedSchedule{Max: 59, At: 30},
+				Min:      resolve.FixedSchedule{Max: 59, At: 0},
+				Hour:     resolve.FixedSchedule{Max: 23, At: 12},
+				DayMonth: resolve.FromEndSchedule{Max: 31, FromEnd: 2},
+				Month:    resolve.Everytime{},
+				DayWeek:  resolve.Everytime{},
+			},
+		},
+		{
+			name:  "Success/FromEnd/RangeIsNotFromEnd",
+			input: "0 0 5-10 * *",
+			wants: Schedule{
+				Sec:      resolve.FixedSchedule{Max: 59, At: 0},
+				Min:      resolve.FixedSchedule{Max: 59, At: 0},
+				Hour:     resolve.FixedSchedule{Max: 23, At: 0},
+				DayMonth: resolve.RangeSchedule{Max: 31, From: 5, To: 10},
+				Month:    resolve.Everytime{},
+				DayWeek:  resolve.Everytime{},
+			},
+		},
+		{
+			name:  "Fail/FromEnd/Zero",
+			input: "0 0 -0 * *",
+			wants: Schedule{},
+			err:   ErrOutOfBoundsAlphanum,
+		},
+		{
+			name:  "Fail/FromEnd/OutOfBounds",
+			input: "0 0 -32 * *",
+			wants: Schedule{},
+			err:   ErrOutOfBoundsAlphanum,
+		},
+		{
+			name:  "Fail/FromEnd/InList",
+			input: "0 0 -1,15 * *",
+			wants: Schedule{},
+			err:   ErrInvalidAlphanum,
+		},
+		{
+			name:  "Fail/FromEnd/InRange",
+			input: "0 0 -3-1 * *",
+			wants: Schedule{},
+			err:   ErrInvalidAlphanum,
+		},
+		{
+			name:  "Fail/FromEnd/WithFrequency",
+			input: "0 0 -1/2 * *",
+			wants: Schedule{},
+			err:   ErrInvalidAlphanum,
+		},
+		{
+			name:  "Fail/FromEnd/NoValue",
+			input: "0 0 - * *",
+			wants: Schedule{},
+			err:   ErrInvalidNumEdges,
+		},
+		{
+			name:  "Fail/FromEnd/Name",
+			input: "0 0 -L * *",
+			wants: Schedule{},
+			err:   ErrUnsupportedAlphanum,
+		},
+		{
+			name:  "Fail/FromEnd/InMonths",
+			input: "0 0 1 -1 *",
+			wants: Schedule{},
+			err:   ErrInvalidNodeType,
+		},
+		{
+			name:  "Fail/FromEnd/InMinutes",
+			input: "-1 0 1 * *",
+			wants: Schedule{},
+			err:   ErrInvalidNodeType,
+		},
 	} {
 		t.Run(testcase.name, func(t *testing.T) {
 			cron, err := Parse(testcase.input)
 
 			is.True(t, errors.Is(err, testcase.err))
 			require.Equal(t, testcase.wants, cron)
+
+			// validating the cron string alone must yield the same errors as parsing it
+			is.True(t, errors.Is(ValidateString(testcase.input), testcase.err))
+		})
+	}
+}
+
+func TestParse_PositionError(t *testing.T) {
+	for _, testcase := range []struct {
+		name   string
+		input  string
+		field  int
+		offset int
+		err    error
+	}{
+		{
+			name:   "InvalidMonthInList",
+			input:  "* * * jan,jen,jin *",
+			field:  3,
+			offset: 10,
+			err:    ErrInvalidAlphanum,
+		},
+		{
+			name:   "InvalidRangeStart",
+			input:  "0 0 * * FOO-FRI",
+			field:  4,
+			offset: 8,
+			err:    ErrInvalidAlphanum,
+		},
+		{
+			name:   "ZeroStep",
+			input:  "*/0 * * * *",
+			field:  0,
+			offset: 2,
+			err:    ErrInvalidFrequency,
+		},
+		{
+			name:   "OutOfBoundsWithSeconds",
+			input:  "0 0 24 * * *",
+			field:  2,
+			offset: 4,
+			err:    ErrOutOfBoundsAlphanum,
+		},
+		{
+			name:   "OutOfBoundsYear",
+			input:  "0 0 0 1 1 * 2100",
+			field:  6,
+			offset: 12,
+			err:    ErrOutOfBoundsAlphanum,
+		},
+		{
+			name:   "InvertedRangeYear",
+			input:  "0 0 0 1 1 * 2030-2025",
+			field:  6,
+			offset: 17,
+			err:    ErrInvalidRange,
+		},
+		{
+			name:   "ZeroStepYear",
+			input:  "0 0 0 1 1 * 2030/0",
+			field:  6,
+			offset: 17,
+			err:    ErrInvalidFrequency,
+		},
+		{
+			name:   "InvalidOverride",
+			input:  "@take-a-guess",
+			field:  0,
+			offset: 1,
+			err:    ErrInvalidFrequency,
+		},
+		{
+			name:   "EveryTooShort",
+			input:  "@every 1ms",
+			field:  0,
+			offset: 7,
+			err:    ErrOutOfBoundsDuration,
+		},
+		{
+			name:   "FromEndInList",
+			input:  "0 0 -1,15 * *",
+			field:  2,
+			offset: 6,
+			err:    ErrInvalidAlphanum,
+		},
+		{
+			name:   "FromEndListOfFromEnd",
+			input:  "0 0 -2,-1 * *",
+			field:  2,
+			offset: 6,
+			err:    ErrInvalidAlphanum,
+		},
+		{
+			name:   "FromEndAfterComma",
+			input:  "0 0 1,-1 * *",
+			field:  2,
+			offset: 6,
+			err:    ErrInvalidAlphanum,
+		},
+		{
+			name:   "FromEndAfterCommaWithSeconds",
+			input:  "0 0 0 1,15,-1 * *",
+			field:  3,
+			offset: 11,
+			err:    ErrInvalidAlphanum,
+		},
+		{
+			name:   "FromEndOutOfBounds",
+			input:  "0 0 -32 * *",
+			field:  2,
+			offset: 5,
+			err:    ErrOutOfBoundsAlphanum,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			_, err := Parse(testcase.input)
+			is.True(t, errors.Is(err, testcase.err))
+
+			var posErr *PositionError
+			is.True(t, errors.As(err, &posErr))
+			is.Equal(t, testcase.field, posErr.Field)
+			is.Equal(t, testcase.offset, posErr.Offset)
+		})
+	}
+}
+
+func TestParseStrict(t *testing.T) {
+	for _, testcase := range []struct {
+		name  string
+		input string
+		err   error
+	}{
+		{
+			name:  "Success/FiveFields",
+			input: "*/5 * * * MON-FRI",
+		},
+		{
+			name:  "Success/Override",
+			input: "@hourly",
+		},
+		{
+			name:  "Success/EveryMinute",
+			input: "@every 1m",
+		},
+		{
+			name:  "Fail/WithSeconds",
+			input: "0 */5 * * * *",
+			err:   ErrUnsupportedSeconds,
+		},
+		{
+			name:  "Fail/WithYears",
+			input: "0 0 0 1 1 * 2025",
+			err:   ErrUnsupportedSeconds,
+		},
+		{
+			name:  "Fail/Secondly",
+			input: "@secondly",
+			err:   ErrUnsupportedSeconds,
+		},
+		{
+			name:  "Fail/EveryBelowMinute",
+			input: "@every 30s",
+			err:   ErrOutOfBoundsDuration,
+		},
+		{
+			name:  "Fail/Invalid",
+			input: "* * * jan,jen *",
+			err:   ErrInvalidAlphanum,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			s, err := ParseStrict(testcase.input)
+			is.True(t, errors.Is(err, testcase.err))
+
+			if testcase.err != nil {
+				require.Equal(t, Schedule{}, s)
+
+				return
+			}
+
+			// strict parsing yields the same Schedule as Parse on the accepted cron strings
+			wants, err := Parse(testcase.input)
+			is.Empty(t, err)
+			require.Equal(t, wants, s)
+		})
+	}
+}
+
+func TestParseLenient(t *testing.T) {
+	for _, testcase := range []struct {
+		name  string
+		input string
+		wants string
+		err   error
+	}{
+		{
+			name:  "Success/MinuteAndHour",
+			input: "30 4",
+			wants: "30 4 * * *",
+		},
+		{
+			name:  "Success/MinuteOnly",
+			input: "*/15",
+			wants: "*/15 * * * *",
+		},
+		{
+			name:  "Success/FourFieldsWithTabs",
+			input: "0\t9\t1\tJAN-MAR",
+			wants: "0 9 1 JAN-MAR *",
+		},
+		{
+			name:  "Success/FiveFields",
+			input: "0 9 * * MON-FRI",
+			wants: "0 9 * * MON-FRI",
+		},
+		{
+			name:  "Success/WithSeconds",
+			input: "30 0 9 * * *",
+			wants: "30 0 9 * * *",
+		},
+		{
+			name:  "Success/Override",
+			input: "@daily",
+			wants: "@daily",
+		},
+		{
+			name:  "Fail/InvalidField",
+			input: "30 25",
+			err:   ErrOutOfBoundsAlphanum,
+		},
+		{
+			name:  "Fail/WithYears",
+			input: "0 0 0 1 1 * 2025",
+			err:   ErrInvalidNumNodes,
+		},
+		{
+			name:  "Fail/Empty",
+			input: "",
+			err:   ErrEmptyInput,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			s, err := ParseLenient(testcase.input)
+			is.True(t, errors.Is(err, testcase.err))
+
+			if testcase.err != nil {
+				require.Equal(t, Schedule{}, s)
+
+				return
+			}
+
+			wants, err := Parse(testcase.wants)
+			is.Empty(t, err)
+			require.Equal(t, wants, s)
+		})
+	}
+}
+
+func TestMustParse(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		wants, err := Parse("0 0 * * *")
+		is.Empty(t, err)
+
+		require.Equal(t, wants, MustParse("0 0 * * *"))
+	})
+
+	t.Run("Panics", func(t *testing.T) {
+		defer func() {
+			err, ok := recover().(error)
+			is.True(t, ok)
+			is.True(t, errors.Is(err, ErrInvalidFrequency))
+		}()
+
+		_ = MustParse("@take-a-guess")
+
+		t.Error("expected MustParse to panic")
+	})
+}
+
+func TestParseWithLocation(t *testing.T) {
+	everyDayAtNine := Schedule{
+		Sec:      resolve.FixedSchedule{Max: 59, At: 0},
+		Min:      resolve.FixedSchedule{Max: 59, At: 0},
+		Hour:     resolve.FixedSchedule{Max: 23, At: 9},
+		DayMonth: resolve.Everytime{},
+		Month:    resolve.Everytime{},
+		DayWeek:  resolve.Everytime{},
+	}
+
+	for _, testcase := range []struct {
+		name  string
+		input string
+		wants Schedule
+		loc   string
+		err   error
+	}{
+		{
+			name:  "Success/NoLocation",
+			input: "0 9 * * *",
+			wants: everyDayAtNine,
+		},
+		{
+			name:  "Success/CronTZ",
+			input: "CRON_TZ=America/New_York 0 9 * * *",
+			wants: everyDayAtNine,
+			loc:   "America/New_York",
+		},
+		{
+			name:  "Success/TZ",
+			input: "TZ=UTC 0 9 * * *",
+			wants: everyDayAtNine,
+			loc:   "UTC",
+		},
+		{
+			name:  "Success/TabSeparated",
+			input: "CRON_TZ=UTC\t0 9 * * *",
+			wants: everyDayAtNine,
+			loc:   "UTC",
+		},
+		{
+			name:  "Success/MixedWhitespace",
+			input: "TZ=UTC \t 0\t9 * * *",
+			wants: everyDayAtNine,
+			loc:   "UTC",
+		},
+		{
+			name:  "Fail/UnknownZone",
+			input: "CRON_TZ=Nowhere/Atlantis 0 9 * * *",
+			err:   ErrInvalidLocation,
+		},
+		{
+			name:  "Fail/EmptyZone",
+			input: "TZ= 0 9 * * *",
+			err:   ErrInvalidLocation,
+		},
+		{
+			name:  "Fail/NoSchedule",
+			input: "TZ=UTC",
+			err:   ErrEmptyInput,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			cron, loc, err := ParseWithLocation(testcase.input)
+
+			is.True(t, errors.Is(err, testcase.err))
+			require.Equal(t, testcase.wants, cron)
+
+			if testcase.loc == "" {
+				is.True(t, loc == nil)
+
+				return
+			}
+
+			is.Equal(t, testcase.loc, loc.String())
+		})
+	}
+}
+
+func TestSchedule_String(t *testing.T) {
+	for _, testcase := range []struct {
+		name  string
+		input string
+		wants string
+	}{
+		{
+			name:  "AllStar",
+			input: "* * * * *",
+			wants: "* * * * *",
+		},
+		{
+			name:  "AllStarWithSeconds",
+			input: "* * * * * *",
+			wants: "* * * * * *",
+		},
+		{
+			name:  "DefaultSecondsOmitted",
+			input: "0 0 9 * * *",
+			wants: "0 9 * * *",
+		},
+		{
+			name:  "FixedAndRange",
+			input: "30 9 1-15 * 1-5",
+			wants: "30 9 1-15 * 1-5",
+		},
+		{
+			name:  "WrapAroundRange",
+			input: "0 22-2 * * *",
+			wants: "0 22-2 * * *",
+		},
+		{
+			name:  "StepsAndNames",
+			input: "*/15 0 1 jan,jul mon,wed,fri",
+			wants: "0,15,30,45 0 1 1,7 1,3,5",
+		},
+		{
+			name:  "WithYear",
+			input: "0 0 0 1 1 * 2025-2027",
+			wants: "0 0 0 1 1 * 2025,2026,2027",
+		},
+		{
+			name:  "FromEnd",
+			input: "0 18 -2 * *",
+			wants: "0 18 -2 * *",
+		},
+		{
+			name:  "Override",
+			input: "@daily",
+			wants: "0 0 * * *",
+		},
+		{
+			name:  "Reboot",
+			input: "@reboot",
+			wants: "@reboot",
+		},
+		{
+			name:  "Every",
+			input: "@every 90s",
+			wants: "@every 1m30s",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			sched, err := Parse(testcase.input)
+			is.Empty(t, err)
+			is.Equal(t, testcase.wants, sched.String())
+
+			// the rendered cron string must parse back into the same Schedule
+			roundTrip, err := Parse(sched.String())
+			is.Empty(t, err)
+			require.Equal(t, sched, roundTrip)
 		})
 	}
 }
@@ -578,12 +1641,19 @@ func FuzzParse(f *testing.F) {
 	f.Add("0 0 * * sun,Mon,TUE,wED,thU,FrI,sAt")
 	f.Add("@reboot")
 	f.Add("@hourly")
+	f.Add("@minutely")
+	f.Add("@secondly")
 	f.Add("@daily")
 	f.Add("@weekly")
 	f.Add("@monthly")
 	f.Add("@annually")
 	f.Add("@yearly")
+	f.Add("@every 90s")
+	f.Add("@every 2h30m")
+	f.Add("@every 500ms")
 	f.Add("* * * * * *")
+	f.Add("0 0 0 1 1 * 2025")
+	f.Add("0 0 0 1 1 * 2025-2027")
 	f.Add("@take-a-guess")
 	f.Add("* * * * 0-!")
 	f.Add("* * * * 0//")
@@ -592,19 +1662,66 @@ func FuzzParse(f *testing.F) {
 	f.Add("*/A * * * *")
 	f.Add("0/-3 * * * *")
 	f.Add("0/64 * * * *")
+	f.Add("*/0 * * * *")
+	f.Add("5/0 * * * *")
 	f.Add("* * * * 0,1,2,3,4,5,6,7,8,9")
 
 	f.Fuzz(func(t *testing.T, s string) {
 		_, err := Parse(s)
 
+		if validateErr := ValidateString(s); (validateErr == nil) != (err == nil) {
+			t.Errorf("mismatched validation error: %v -- parse error: %v -- input: %q", validateErr, err, s)
+		}
+
 		switch {
 		case err == nil, errors.Is(err, ErrInvalidNumNodes), errors.Is(err, ErrInvalidNodeType),
 			errors.Is(err, ErrInvalidNumEdges), errors.Is(err, ErrInvalidFrequency),
 			errors.Is(err, ErrUnsupportedAlphanum), errors.Is(err, ErrOutOfBoundsAlphanum),
 			errors.Is(err, ErrEmptyAlphanum), errors.Is(err, ErrInvalidAlphanum),
-			errors.Is(err, ErrInvalidCharacter), errors.Is(err, ErrEmptyInput):
+			errors.Is(err, ErrInvalidCharacter), errors.Is(err, ErrEmptyInput),
+			errors.Is(err, ErrInvalidDuration), errors.Is(err, ErrOutOfBoundsDuration):
 		default:
 			t.Errorf("unexpected error: %v -- input: %q", err, s)
 		}
 	})
 }
+
+func TestBuildFreq(t *testing.T) {
+	for _, testcase := range []struct {
+		name    string
+		base    int
+		maximum int
+		freq    int
+		wants   []int
+	}{
+		{
+			name:    "FromZero",
+			base:    0,
+			maximum: 59,
+			freq:    20,
+			wants:   []int{0, 20, 40},
+		},
+		{
+			name:    "FromOffset",
+			base:    10,
+			maximum: 23,
+			freq:    4,
+			wants:   []int{10, 14, 18, 22},
+		},
+		{
+			name:    "BaseAtMaximum",
+			base:    12,
+			maximum: 12,
+			freq:    5,
+			wants:   []int{12},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			values := buildFreq(testcase.base, testcase.maximum, testcase.freq)
+
+			is.EqualElements(t, testcase.wants, values)
+			is.Equal(t, (testcase.maximum-testcase.base)/testcase.freq+1, len(values))
+			is.Equal(t, len(values), cap(values))
+		})
+	}
+}
@@ -1,9 +1,12 @@
 package cronlex
 
 import (
+	"fmt"
 	"slices"
 	"strconv"
 	"strings"
+	"time"
+	"unicode"
 
 	"github.com/zalgonoise/parse"
 
@@ -17,6 +20,8 @@ const (
 	maxDay     = 31
 	maxMonth   = 12
 	maxWeekday = 7
+	minYear    = 1970
+	maxYear    = 2099
 
 	extraSunday = 7
 
@@ -31,6 +36,9 @@ const (
 	monthly
 	yearly
 	annually
+	every
+	minutely
+	secondly
 )
 
 // Resolver describes the capabilities of a cron schedule resolver.
@@ -38,21 +46,33 @@ const (
 // Implementations of Resolver should focus on calculating the difference until the
 // next scheduled value, on a per-unit basis. This means that for each configurable schedule element
 // (seconds, minutes, hours, etc.), an individual Resolver calculates the next occurrence for a given value.
+// Likewise, ResolvePrev calculates the difference since the previous scheduled value.
 //
-// In the context of dates and timestamps, it enables to simply resolve the next occurrence's date as a difference
-// of the current time's units against the Resolver's configuration, and with that information to build the
-// timestamp for the next job execution, with a time.Date call, in the schedule.Scheduler component, that would sum the
-// current time to the values taken from the Resolver.
+// In the context of dates and timestamps, it enables to simply resolve the next (or previous) occurrence's date as a
+// difference of the current time's units against the Resolver's configuration, and with that information to build the
+// timestamp for the next job execution in the schedule.Scheduler component. A zero difference means that the value is
+// an occurrence, and a non-zero difference must always be returned for values that aren't.
 //
 // Implementations of Resolver must ensure that their logic functions for all date elements of Schedule, provided that
 // the Resolver is used in that data structure.
 type Resolver interface {
 	// Resolve returns the distance to the next occurrence, as unit values.
 	Resolve(value int) int
+	// ResolvePrev returns the distance to the previous occurrence, as unit values.
+	ResolvePrev(value int) int
+	// Contains returns true if the input value is an occurrence.
+	Contains(value int) bool
 }
 
 // Schedule describes the structure of an (extended) cron schedule, which includes all basic cron schedule elements
-// (minutes, hours, day-of-the-month, month and weekdays), as well as support for seconds.
+// (minutes, hours, day-of-the-month, month and weekdays), as well as support for seconds and an optional year.
+//
+// The Year element is only set when parsing a 7-field cron string, otherwise it is nil and does not constrain the
+// schedule.
+//
+// A Schedule created from an `@every <duration>` override only sets its Every field, leaving all Resolver elements
+// unset, as the schedule is not aligned to any wall-clock field. Similarly, a Schedule created from an `@reboot`
+// override only sets its Once field.
 type Schedule struct {
 	Sec      Resolver
 	Min      Resolver
@@ -60,13 +80,87 @@ type Schedule struct {
 	DayMonth Resolver
 	Month    Resolver
 	DayWeek  Resolver
+	Year     Resolver
+
+	// Every defines a fixed interval between executions, when set from an `@every <duration>` override.
+	Every time.Duration
+	// Once defines that the schedule triggers a single time on startup, when set from an `@reboot` override.
+	Once bool
+}
+
+// String renders the Schedule back into a canonical cron string, which parses back into the same Schedule.
+//
+// The seconds field is omitted when it is set to the default (fixed at zero) and the Schedule does not constrain the
+// year; while overrides like `@reboot` and `@every <duration>` are rendered in their original form.
+func (s Schedule) String() string {
+	switch {
+	case s.Once:
+		return "@reboot"
+	case s.Every > 0:
+		return "@every " + s.Every.String()
+	}
+
+	fields := make([]string, 0, withYears)
+
+	if s.Year != nil || s.Sec != (resolve.FixedSchedule{Max: maxSec, At: 0}) {
+		fields = append(fields, formatResolver(s.Sec))
+	}
+
+	fields = append(fields,
+		formatResolver(s.Min),
+		formatResolver(s.Hour),
+		formatResolver(s.DayMonth),
+		formatResolver(s.Month),
+		formatResolver(s.DayWeek),
+	)
+
+	if s.Year != nil {
+		fields = append(fields, formatResolver(s.Year))
+	}
+
+	return strings.Join(fields, " ")
+}
+
+func formatResolver(r Resolver) string {
+	switch v := r.(type) {
+	case resolve.FixedSchedule:
+		return strconv.Itoa(v.At)
+	case resolve.RangeSchedule:
+		return strconv.Itoa(v.From) + "-" + strconv.Itoa(v.To)
+	case resolve.StepSchedule:
+		return formatValues(v.Steps)
+	case resolve.YearSchedule:
+		return formatValues(v.Years)
+	case resolve.FromEndSchedule:
+		return "-" + strconv.Itoa(v.FromEnd)
+	default:
+		// resolve.Everytime, unset and unknown resolvers
+		return "*"
+	}
+}
+
+func formatValues(values []int) string {
+	if len(values) == 0 {
+		return "*"
+	}
+
+	fields := make([]string, 0, len(values))
+
+	for i := range values {
+		fields = append(fields, strconv.Itoa(values[i]))
+	}
+
+	return strings.Join(fields, ",")
 }
 
 // Parse consumes the input cron string and creates a Schedule from it, also returning an error if raised.
 //
 // Before parsing the string, this function validates that the cron string does not contain any illegal characters,
-// before actually scanning and processing it.
+// before actually scanning and processing it. Custom overrides registered with RegisterOverride are replaced with their
+// expression.
 func Parse(cron string) (Schedule, error) {
+	cron = expandOverride(cron)
+
 	if err := validateCharacters(cron); err != nil {
 		return Schedule{}, err
 	}
@@ -74,6 +168,133 @@ func Parse(cron string) (Schedule, error) {
 	return parse.Run([]byte(cron), StateFunc, ParseFunc, ProcessFunc)
 }
 
+// ParseStrict is like Parse but only accepts schedules with a granularity of one minute or above, which are standard
+// 5-field cron strings and overrides other than `@secondly`. It is meant for platforms that enforce a minimum
+// granularity, where a sub-minute schedule should be rejected instead of silently accepted.
+//
+// Cron strings with a seconds field (with 6 or 7 fields) and the `@secondly` override result in an
+// ErrUnsupportedSeconds error, while `@every` overrides with a duration below one minute result in an
+// ErrOutOfBoundsDuration error.
+func ParseStrict(cron string) (Schedule, error) {
+	cron = expandOverride(cron)
+
+	if err := validateCharacters(cron); err != nil {
+		return Schedule{}, err
+	}
+
+	return parse.Run([]byte(cron), StateFunc, ParseFunc, processStrict)
+}
+
+func processStrict(t *parse.Tree[Token, byte]) (Schedule, error) {
+	s, err := ProcessFunc(t)
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	if err = validateStrict(t); err != nil {
+		return Schedule{}, err
+	}
+
+	return s, nil
+}
+
+// ParseLenient is like Parse but accepts cron strings with fewer than five fields, filling the missing trailing fields
+// with wildcards (`*`). For example, `30 4` is parsed as `30 4 * * *`, meaning minute 30 of hour 4, every day.
+//
+// The supplied fields are still validated as in Parse. Overrides (like `@daily`) are parsed as-is, and cron strings
+// with more than six fields (with years) result in an ErrInvalidNumNodes error.
+func ParseLenient(cron string) (Schedule, error) {
+	fields := strings.Fields(cron)
+
+	switch {
+	case len(fields) == 0 || strings.HasPrefix(fields[0], "@"):
+		return Parse(cron)
+	case len(fields) > withSeconds:
+		return Schedule{}, fmt.Errorf("%w: %d", ErrInvalidNumNodes, len(fields))
+	case len(fields) < noSeconds:
+		for len(fields) < noSeconds {
+			fields = append(fields, "*")
+		}
+
+		return Parse(strings.Join(fields, " "))
+	default:
+		return Parse(cron)
+	}
+}
+
+// MustParse is like Parse but panics if the input cron string cannot be parsed. The panic value is an error wrapping
+// the one returned by Parse.
+//
+// It is intended for cron strings known at compile time, like package-level schedules
+// (e.g. `var daily = cronlex.MustParse("0 0 * * *")`). Cron strings from any other source (like user input or
+// configuration files) should be parsed with Parse, handling its error.
+func MustParse(cron string) Schedule {
+	s, err := Parse(cron)
+	if err != nil {
+		panic(fmt.Errorf("cronlex: MustParse(%q): %w", cron, err))
+	}
+
+	return s
+}
+
+// ParseWithLocation consumes the input cron string and creates a Schedule from it, also returning the time.Location
+// that is embedded in the cron string, and an error if raised.
+//
+// A location can be embedded in the cron string as a leading `TZ=` or `CRON_TZ=` prefix, separated from the schedule
+// by whitespace (e.g. `CRON_TZ=America/New_York 0 9 * * *`). The zone name is resolved with a time.LoadLocation call,
+// and an unknown zone name results in an ErrInvalidLocation error.
+//
+// If the cron string does not contain a location prefix, the returned time.Location is nil.
+func ParseWithLocation(cron string) (Schedule, *time.Location, error) {
+	return parseWithLocation(cron, Parse)
+}
+
+// parseWithLocation cuts the location embedded in the input cron string, if any, and parses the rest of it with the
+// input parse function.
+func parseWithLocation(cron string, parseFn func(string) (Schedule, error)) (Schedule, *time.Location, error) {
+	zone, rest, ok := cutLocation(cron)
+	if !ok {
+		s, err := parseFn(cron)
+
+		return s, nil, err
+	}
+
+	if zone == "" {
+		return Schedule{}, nil, fmt.Errorf("%w: empty zone name", ErrInvalidLocation)
+	}
+
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		return Schedule{}, nil, fmt.Errorf("%w [%s]: %w", ErrInvalidLocation, zone, err)
+	}
+
+	s, err := parseFn(rest)
+	if err != nil {
+		return Schedule{}, nil, err
+	}
+
+	return s, loc, nil
+}
+
+func cutLocation(cron string) (zone, rest string, ok bool) {
+	for _, prefix := range locationPrefixes {
+		if !strings.HasPrefix(cron, prefix) {
+			continue
+		}
+
+		zone = strings.TrimPrefix(cron, prefix)
+
+		// the location is separated from the schedule by any whitespace, like the schedule's fields
+		if idx := strings.IndexFunc(zone, unicode.IsSpace); idx >= 0 {
+			zone, rest = zone[:idx], zone[idx:]
+		}
+
+		return zone, strings.TrimLeftFunc(rest, unicode.IsSpace), true
+	}
+
+	return "", cron, false
+}
+
 // ProcessFunc is the third and last phase of the parser, which consumes a parse.Tree scoped to Token and byte,
 // returning the new Schedule and error if raised.
 //
@@ -84,14 +305,16 @@ func ProcessFunc(t *parse.Tree[Token, byte]) (Schedule, error) {
 		return Schedule{}, err
 	}
 
-	var (
-		s     Schedule
-		nodes = t.List()
-	)
+	return buildSchedule(t.List()), nil
+}
+
+// buildSchedule derives a Schedule out of the input (validated) top-level nodes of a parse.Tree.
+func buildSchedule(nodes []*parse.Node[Token, byte]) Schedule {
+	var s Schedule
 
 	switch len(nodes) {
 	case override:
-		return buildException(nodes[0]), nil
+		return buildException(nodes[0])
 	case noSeconds:
 		s = Schedule{
 			Sec: resolve.FixedSchedule{
@@ -113,20 +336,63 @@ func ProcessFunc(t *parse.Tree[Token, byte]) (Schedule, error) {
 			Month:    buildMonths(nodes[4]),
 			DayWeek:  buildWeekdays(nodes[5]),
 		}
+	case withYears:
+		s = Schedule{
+			Sec:      buildSeconds(nodes[0]),
+			Min:      buildMinutes(nodes[1]),
+			Hour:     buildHours(nodes[2]),
+			DayMonth: buildMonthDays(nodes[3]),
+			Month:    buildMonths(nodes[4]),
+			DayWeek:  buildWeekdays(nodes[5]),
+			Year:     buildYears(nodes[6]),
+		}
 	}
-	// convert sundays as 7 into a 0
-	if r, ok := s.DayWeek.(resolve.StepSchedule); ok {
-		for i := range r.Steps {
-			if r.Steps[i] == extraSunday {
-				r.Steps[i] = 0
 
-				slices.Sort(r.Steps)
-				s.DayWeek = r
+	s.DayWeek = normalizeWeekdays(s.DayWeek)
+
+	return s
+}
+
+// normalizeWeekdays converts sundays as 7 into a 0, as time.Weekday values range from 0 (Sunday) to 6 (Saturday).
+func normalizeWeekdays(r Resolver) Resolver {
+	switch v := r.(type) {
+	case resolve.FixedSchedule:
+		if v.At == extraSunday {
+			v.At = 0
+		}
+
+		return v
+	case resolve.RangeSchedule:
+		if v.To == extraSunday {
+			switch v.From {
+			case 0:
+				// 0-7 covers the entire week
+				v.To = extraSunday - 1
+			default:
+				// ranges ending on Sunday wrap around into the start of the week (e.g. 5-7 is 5, 6 and 0)
+				v.To = 0
 			}
 		}
-	}
 
-	return s, nil
+		if v.From == extraSunday {
+			v.From = 0
+		}
+
+		return v
+	case resolve.StepSchedule:
+		for i := range v.Steps {
+			if v.Steps[i] == extraSunday {
+				v.Steps[i] = 0
+			}
+		}
+
+		slices.Sort(v.Steps)
+		v.Steps = slices.Compact(v.Steps)
+
+		return v
+	default:
+		return r
+	}
 }
 
 func buildSeconds(node *parse.Node[Token, byte]) Resolver {
@@ -134,7 +400,7 @@ func buildSeconds(node *parse.Node[Token, byte]) Resolver {
 	case TokenStar:
 		return processStar(node, 0, maxSec)
 	default:
-		return processAlphaNum(node, maxSec, nil)
+		return processAlphaNum(node, 0, maxSec, nil)
 	}
 }
 
@@ -143,7 +409,7 @@ func buildMinutes(node *parse.Node[Token, byte]) Resolver {
 	case TokenStar:
 		return processStar(node, 0, maxMin)
 	default:
-		return processAlphaNum(node, maxMin, nil)
+		return processAlphaNum(node, 0, maxMin, nil)
 	}
 }
 
@@ -152,7 +418,7 @@ func buildHours(node *parse.Node[Token, byte]) Resolver {
 	case TokenStar:
 		return processStar(node, 0, maxHour)
 	default:
-		return processAlphaNum(node, maxHour, nil)
+		return processAlphaNum(node, 0, maxHour, nil)
 	}
 }
 
@@ -160,8 +426,14 @@ func buildMonthDays(node *parse.Node[Token, byte]) Resolver {
 	switch node.Type {
 	case TokenStar:
 		return processStar(node, 1, maxDay)
+	case TokenDash:
+		// input has already been validated, the dash has a single numeric value
+		return resolve.FromEndSchedule{
+			Max:     maxDay,
+			FromEnd: getValue(node.Edges[0], nil),
+		}
 	default:
-		return processAlphaNum(node, maxDay, nil)
+		return processAlphaNum(node, 1, maxDay, nil)
 	}
 }
 
@@ -170,7 +442,7 @@ func buildMonths(node *parse.Node[Token, byte]) Resolver {
 	case TokenStar:
 		return processStar(node, 1, maxMonth)
 	default:
-		return processAlphaNum(node, maxMonth, monthsList)
+		return processAlphaNum(node, 1, maxMonth, monthsList)
 	}
 }
 
@@ -179,7 +451,30 @@ func buildWeekdays(node *parse.Node[Token, byte]) Resolver {
 	case TokenStar:
 		return processStar(node, 0, maxWeekday)
 	default:
-		return processAlphaNum(node, maxWeekday, weekdaysList)
+		return processAlphaNum(node, 0, maxWeekday, weekdaysList)
+	}
+}
+
+func buildYears(node *parse.Node[Token, byte]) Resolver {
+	var r Resolver
+
+	switch node.Type {
+	case TokenStar:
+		r = processStar(node, minYear, maxYear)
+	default:
+		r = processAlphaNum(node, minYear, maxYear, nil)
+	}
+
+	// years do not wrap around, so any resolver that is not a star is converted into a YearSchedule
+	switch v := r.(type) {
+	case resolve.FixedSchedule:
+		return resolve.YearSchedule{Years: []int{v.At}}
+	case resolve.RangeSchedule:
+		return resolve.YearSchedule{Years: buildRange(v.From, v.To)}
+	case resolve.StepSchedule:
+		return resolve.YearSchedule{Years: v.Steps}
+	default:
+		return r
 	}
 }
 
@@ -201,9 +496,8 @@ func buildException(node *parse.Node[Token, byte]) Schedule {
 
 	value := getValue(node.Edges[0], exceptionsList)
 	switch value {
-	// TODO: implement reboot (case 0:)
 	case reboot:
-		return defaultSchedule()
+		return Schedule{Once: true}
 	case daily:
 		return Schedule{
 			Sec:      resolve.FixedSchedule{Max: maxSec, At: 0},
@@ -243,6 +537,32 @@ func buildException(node *parse.Node[Token, byte]) Schedule {
 			Month:    resolve.FixedSchedule{Max: maxMonth, At: 1},
 			DayWeek:  resolve.Everytime{},
 		}
+	case minutely:
+		return Schedule{
+			Sec:      resolve.FixedSchedule{Max: maxSec, At: 0},
+			Min:      resolve.Everytime{},
+			Hour:     resolve.Everytime{},
+			DayMonth: resolve.Everytime{},
+			Month:    resolve.Everytime{},
+			DayWeek:  resolve.Everytime{},
+		}
+	case secondly:
+		return Schedule{
+			Sec:      resolve.Everytime{},
+			Min:      resolve.Everytime{},
+			Hour:     resolve.Everytime{},
+			DayMonth: resolve.Everytime{},
+			Month:    resolve.Everytime{},
+			DayWeek:  resolve.Everytime{},
+		}
+	case every:
+		// input has already been validated, the duration string is well-formed
+		dur, err := time.ParseDuration(string(node.Edges[1].Value))
+		if err != nil {
+			return defaultSchedule()
+		}
+
+		return Schedule{Every: dur}
 	default:
 		// case 1 -- set as default behavior
 		return defaultSchedule()
@@ -259,21 +579,9 @@ func getValue(node *parse.Node[Token, byte], valueList []string) int {
 		}
 	}
 
-	// fallback to using it as a string
-	v := strings.ToUpper(string(value))
+	// fallback to using it as a (possibly spelled-out) name;
 	// input has already been validated, there will be a match.
-	// returning the n variable set here ensures more test coverage
-	n := -1
-
-	for idx := range valueList {
-		if v == valueList[idx] {
-			n = idx
-
-			break
-		}
-	}
-
-	return n
+	return indexOfName(string(value), valueList)
 }
 
 func getValueFromSymbol(symbol *parse.Node[Token, byte], valueList []string) int {
@@ -284,7 +592,7 @@ func getValueFromSymbol(symbol *parse.Node[Token, byte], valueList []string) int
 	return -1
 }
 
-func processAlphaNum(n *parse.Node[Token, byte], maximum int, valueList []string) Resolver {
+func processAlphaNum(n *parse.Node[Token, byte], minimum, maximum int, valueList []string) Resolver {
 	value := getValue(n, valueList)
 
 	switch len(n.Edges) {
@@ -308,38 +616,30 @@ func processAlphaNum(n *parse.Node[Token, byte], maximum int, valueList []string
 		// on a mixed scenario we walk through the edges and build a step-schedule out of the combinations provided
 		// for reference, TokenDash means a range, TokenSlash means a frequency and TokenComma carries the next value
 		//
-		// the value variable is reused for this purpose
+		// each comma-separated group (a value with an optional range and frequency, like `0-10/2`) is only expanded once
+		// it is complete, as the frequency applies to the group's range
+		group := valueGroup{from: value, to: -1}
+
 		for i := range n.Edges {
+			v := getValueFromSymbol(n.Edges[i], valueList)
+			if v < 0 {
+				continue
+			}
+
 			//nolint:exhaustive // no need to check on all token types
 			switch n.Edges[i].Type {
 			case TokenComma:
-				// don't leave the initial value dangling when changing Tokens
-				if i == 0 {
-					stepValues = append(stepValues, value)
-				}
-
-				// it's OK to append the (child) value in a comma node
-				// even if the next node is a range or a frequency, the same value will be included and repeated values deleted
-				//
-				// this Token also sets the `cur` variable in case the following Token is a range or frequency
-				if v := getValueFromSymbol(n.Edges[i], valueList); v >= 0 {
-					stepValues = append(stepValues, v)
-
-					value = v
-				}
-
+				stepValues = append(stepValues, group.values(minimum, maximum)...)
+				group = valueGroup{from: v, to: -1}
 			case TokenDash:
-				if to := getValueFromSymbol(n.Edges[i], valueList); to >= 0 {
-					stepValues = append(stepValues, buildRange(value, to)...)
-				}
-
+				group.to = v
 			case TokenSlash:
-				if freq := getValueFromSymbol(n.Edges[i], valueList); freq >= 0 {
-					stepValues = append(stepValues, buildFreq(value, maximum, freq)...)
-				}
+				group.freq = v
 			}
 		}
 
+		stepValues = append(stepValues, group.values(minimum, maximum)...)
+
 		slices.Sort(stepValues)
 		stepValues = slices.Compact(stepValues)
 
@@ -350,6 +650,37 @@ func processAlphaNum(n *parse.Node[Token, byte], maximum int, valueList []string
 	}
 }
 
+// valueGroup is a comma-separated group of values in a cron field, made of a value with an optional range (when to is
+// zero or above) and frequency (when freq is above zero), like `5`, `0-10`, `5/15` or `0-10/2`.
+type valueGroup struct {
+	from int
+	to   int
+	freq int
+}
+
+// values expands the valueGroup into its values, which are every freq-th value of the range (from from to to), or from
+// from up to the maximum value if no range is set.
+func (g valueGroup) values(minimum, maximum int) []int {
+	switch {
+	case g.to < 0 && g.freq <= 0:
+		return []int{g.from}
+	case g.to < 0:
+		return buildFreq(g.from, maximum, g.freq)
+	case g.freq <= 0:
+		return buildWrappingRange(g.from, g.to, minimum, maximum)
+	}
+
+	// ranges are stepped in their order, so that wrapping ranges (like hours 22-2/2) also step across the maximum value
+	r := buildWrappingRange(g.from, g.to, minimum, maximum)
+	out := make([]int, 0, len(r)/g.freq+1)
+
+	for i := 0; i < len(r); i += g.freq {
+		out = append(out, r[i])
+	}
+
+	return out
+}
+
 func processStar(n *parse.Node[Token, byte], minimum, maximum int) Resolver {
 	switch len(n.Edges) {
 	case 1:
@@ -380,12 +711,22 @@ func buildRange(from, to int) []int {
 	return out
 }
 
+// buildWrappingRange builds a range of values like buildRange, but supporting ranges that wrap around the maximum
+// value (e.g. hours 22-2 resolving to 22, 23, 0, 1 and 2).
+func buildWrappingRange(from, to, minimum, maximum int) []int {
+	if to >= from {
+		return buildRange(from, to)
+	}
+
+	return append(buildRange(from, maximum), buildRange(minimum, to)...)
+}
+
 func buildFreq(base, maximum, freq int) []int {
 	if freq == 0 || base > maximum {
 		return []int{}
 	}
 
-	out := make([]int, 0, maximum-base/freq)
+	out := make([]int, 0, (maximum-base)/freq+1)
 	for i := base; i <= maximum; i += freq {
 		out = append(out, i)
 	}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/zalgonoise/parse"
 	"github.com/zalgonoise/x/errs"
@@ -17,6 +18,7 @@ const (
 	ErrInvalid     = errs.Kind("invalid")
 	ErrUnsupported = errs.Kind("unsupported")
 	ErrOutOfBounds = errs.Kind("out-of-bounds")
+	ErrReserved    = errs.Kind("reserved")
 
 	ErrInput     = errs.Entity("input")
 	ErrNumNodes  = errs.Entity("number of nodes")
@@ -25,18 +27,30 @@ const (
 	ErrFrequency = errs.Entity("frequency")
 	ErrAlphanum  = errs.Entity("alphanumeric value")
 	ErrCharacter = errs.Entity("character")
+	ErrDuration  = errs.Entity("duration")
+	ErrRange     = errs.Entity("range")
+	ErrLocation  = errs.Entity("location")
+	ErrOverride  = errs.Entity("override")
+	ErrWeekStart = errs.Entity("week start")
 
+	ErrSeconds   = errs.Entity("seconds value")
 	ErrMinutes   = errs.Entity("minutes value")
 	ErrHours     = errs.Entity("hours value")
 	ErrMonthDays = errs.Entity("days of the month value")
 	ErrMonths    = errs.Entity("month value")
 	ErrWeekDays  = errs.Entity("days of the week value")
+	ErrYears     = errs.Entity("years value")
 )
 
 const (
 	override    = 1
 	noSeconds   = 5
 	withSeconds = 6
+	withYears   = 7
+
+	everyEdges        = 2
+	minInterval       = time.Second
+	minStrictInterval = time.Minute
 )
 
 var (
@@ -45,11 +59,22 @@ var (
 	ErrInvalidNodeType     = errs.WithDomain(errDomain, ErrInvalid, ErrNodeType)
 	ErrInvalidNumEdges     = errs.WithDomain(errDomain, ErrInvalid, ErrNumEdges)
 	ErrInvalidFrequency    = errs.WithDomain(errDomain, ErrInvalid, ErrFrequency)
+	ErrInvalidRange        = errs.WithDomain(errDomain, ErrInvalid, ErrRange)
+	ErrUnsupportedSeconds  = errs.WithDomain(errDomain, ErrUnsupported, ErrSeconds)
 	ErrUnsupportedAlphanum = errs.WithDomain(errDomain, ErrUnsupported, ErrAlphanum)
 	ErrOutOfBoundsAlphanum = errs.WithDomain(errDomain, ErrOutOfBounds, ErrAlphanum)
 	ErrEmptyAlphanum       = errs.WithDomain(errDomain, ErrEmpty, ErrAlphanum)
 	ErrInvalidAlphanum     = errs.WithDomain(errDomain, ErrInvalid, ErrAlphanum)
 	ErrInvalidCharacter    = errs.WithDomain(errDomain, ErrInvalid, ErrCharacter)
+	ErrInvalidDuration     = errs.WithDomain(errDomain, ErrInvalid, ErrDuration)
+	ErrOutOfBoundsDuration = errs.WithDomain(errDomain, ErrOutOfBounds, ErrDuration)
+	ErrInvalidLocation     = errs.WithDomain(errDomain, ErrInvalid, ErrLocation)
+	ErrInvalidOverride     = errs.WithDomain(errDomain, ErrInvalid, ErrOverride)
+	ErrReservedOverride    = errs.WithDomain(errDomain, ErrReserved, ErrOverride)
+	ErrInvalidWeekStart    = errs.WithDomain(errDomain, ErrInvalid, ErrWeekStart)
+
+	//nolint:gochecknoglobals // immutable slice used when parsing a cron string with an embedded location
+	locationPrefixes = []string{"CRON_TZ=", "TZ="}
 
 	//nolint:gochecknoglobals // immutable slice used in validation
 	monthsList = []string{
@@ -80,6 +105,29 @@ var (
 		7: "SUN", // non-standard
 	}
 
+	//nolint:gochecknoglobals // immutable map used in validation, for the spelled-out months and weekdays
+	fullNames = map[string]string{
+		"JAN": "JANUARY",
+		"FEB": "FEBRUARY",
+		"MAR": "MARCH",
+		"APR": "APRIL",
+		"MAY": "MAY",
+		"JUN": "JUNE",
+		"JUL": "JULY",
+		"AUG": "AUGUST",
+		"SEP": "SEPTEMBER",
+		"OCT": "OCTOBER",
+		"NOV": "NOVEMBER",
+		"DEC": "DECEMBER",
+		"SUN": "SUNDAY",
+		"MON": "MONDAY",
+		"TUE": "TUESDAY",
+		"WED": "WEDNESDAY",
+		"THU": "THURSDAY",
+		"FRI": "FRIDAY",
+		"SAT": "SATURDAY",
+	}
+
 	//nolint:gochecknoglobals // immutable slice used in validation
 	exceptionsList = []string{
 		0: "REBOOT",
@@ -89,9 +137,81 @@ var (
 		4: "MONTHLY",
 		5: "ANNUALLY",
 		6: "YEARLY",
+		7: "EVERY",
+		8: "MINUTELY",
+		9: "SECONDLY",
 	}
 )
 
+// PositionError is a validation error raised on a field of a cron string, carrying the index of the field and the byte
+// offset of the offending value in the cron string.
+//
+// It wraps the validation error, which is still reachable with errors.Is and errors.As.
+type PositionError struct {
+	// Field is the zero-based index of the field in the cron string. For a cron string without seconds, the minutes are
+	// the field at index zero.
+	Field int
+	// Offset is the byte offset of the offending value in the cron string.
+	Offset int
+	// Err is the validation error.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *PositionError) Error() string {
+	return fmt.Sprintf("field %d at offset %d: %v", e.Field, e.Offset, e.Err)
+}
+
+// Unwrap returns the underlying validation error.
+func (e *PositionError) Unwrap() error {
+	return e.Err
+}
+
+// offsetError marks the byte offset of the value that raised the wrapped error, so that it is reported in a
+// PositionError.
+type offsetError struct {
+	offset int
+	err    error
+}
+
+func (e offsetError) Error() string {
+	return e.err.Error()
+}
+
+func (e offsetError) Unwrap() error {
+	return e.err
+}
+
+// atNode wraps a non-nil input error with the offset of the input node.
+func atNode(node *parse.Node[Token, byte], err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return offsetError{offset: node.Pos, err: err}
+}
+
+// atField wraps a non-nil input error in a PositionError for the field with the input index, which starts on the
+// input node. If the error was raised on a specific value of the field, its offset is used instead of the node's.
+func atField(field int, node *parse.Node[Token, byte], err error) error {
+	if err == nil {
+		return nil
+	}
+
+	offset := node.Pos
+
+	var valueErr offsetError
+	if errors.As(err, &valueErr) {
+		offset = valueErr.offset
+	}
+
+	return &PositionError{
+		Field:  field,
+		Offset: offset,
+		Err:    err,
+	}
+}
+
 func validateCharacters(s string) error {
 	if s == "" {
 		return ErrEmptyInput
@@ -102,6 +222,7 @@ func validateCharacters(s string) error {
 			(s[i] >= 'A' && s[i] <= 'Z') ||
 			(s[i] >= '0' && s[i] <= '9') ||
 			s[i] == ' ' ||
+			s[i] == '\t' ||
 			s[i] == '*' ||
 			s[i] == ',' ||
 			s[i] == '/' ||
@@ -110,58 +231,156 @@ func validateCharacters(s string) error {
 			continue
 		}
 
-		return fmt.Errorf("%w: %v -- %q", ErrInvalidCharacter, s[i], s)
+		return fmt.Errorf("%w: %v at offset %d -- %q", ErrInvalidCharacter, s[i], i, s)
 	}
 
 	return nil
 }
 
+// ValidateString checks the input cron string for any illegal characters, before scanning, parsing and validating
+// its parse.Tree, returning an error if raised.
+//
+// It returns the same errors that Parse would, without building the resulting Schedule.
+func ValidateString(cron string) error {
+	if err := validateCharacters(cron); err != nil {
+		return err
+	}
+
+	_, err := parse.Run([]byte(cron), StateFunc, ParseFunc, validateTree)
+
+	return err
+}
+
+func validateTree(t *parse.Tree[Token, byte]) (struct{}, error) {
+	return struct{}{}, Validate(t)
+}
+
 // Validate scans the entire parse.Tree for inconsistencies or validation errors, returning them if raised.
+//
+// Errors raised on a field of the cron string are wrapped in a PositionError, pointing to the offending field and value.
 func Validate(t *parse.Tree[Token, byte]) error {
 	nodes := t.List()
 
 	switch len(nodes) {
 	case override:
-		return validateOverride(nodes[0])
+		// a value counted back from the end (e.g. `-1`) is a field, not an override
+		if nodes[0].Type == TokenDash {
+			return fmt.Errorf("%w: %d", ErrInvalidNumNodes, len(nodes))
+		}
+
+		return atField(0, nodes[0], validateOverride(nodes[0]))
 	case noSeconds:
 		return errors.Join(
-			validateMinutes(nodes[0]),
-			validateHours(nodes[1]),
-			validateMonthDays(nodes[2]),
-			validateMonths(nodes[3]),
-			validateWeekDays(nodes[4]),
+			atField(0, nodes[0], validateMinutes(nodes[0])),
+			atField(1, nodes[1], validateHours(nodes[1])),
+			atField(2, nodes[2], validateMonthDays(nodes[2])),
+			atField(3, nodes[3], validateMonths(nodes[3])),
+			atField(4, nodes[4], validateWeekDays(nodes[4])),
 		)
 	case withSeconds:
 		return errors.Join(
-			validateSeconds(nodes[0]),
-			validateMinutes(nodes[1]),
-			validateHours(nodes[2]),
-			validateMonthDays(nodes[3]),
-			validateMonths(nodes[4]),
-			validateWeekDays(nodes[5]),
+			atField(0, nodes[0], validateSeconds(nodes[0])),
+			atField(1, nodes[1], validateMinutes(nodes[1])),
+			atField(2, nodes[2], validateHours(nodes[2])),
+			atField(3, nodes[3], validateMonthDays(nodes[3])),
+			atField(4, nodes[4], validateMonths(nodes[4])),
+			atField(5, nodes[5], validateWeekDays(nodes[5])),
+		)
+	case withYears:
+		return errors.Join(
+			atField(0, nodes[0], validateSeconds(nodes[0])),
+			atField(1, nodes[1], validateMinutes(nodes[1])),
+			atField(2, nodes[2], validateHours(nodes[2])),
+			atField(3, nodes[3], validateMonthDays(nodes[3])),
+			atField(4, nodes[4], validateMonths(nodes[4])),
+			atField(5, nodes[5], validateWeekDays(nodes[5])),
+			atField(6, nodes[6], validateYears(nodes[6])),
 		)
 	default:
 		return fmt.Errorf("%w: %d", ErrInvalidNumNodes, len(nodes))
 	}
 }
 
+// validateStrict ensures that the input parse.Tree does not describe a sub-minute schedule, as required by ParseStrict.
+//
+// It rejects cron strings with a seconds field (with 6 or 7 fields), the `@secondly` override and `@every` overrides
+// with a duration below one minute.
+func validateStrict(t *parse.Tree[Token, byte]) error {
+	nodes := t.List()
+
+	switch len(nodes) {
+	case withSeconds, withYears:
+		return atField(0, nodes[0], fmt.Errorf("%w: %d fields, only %d are allowed", ErrUnsupportedSeconds, len(nodes), noSeconds))
+	case override:
+		if len(nodes[0].Edges) == 0 {
+			return nil
+		}
+
+		switch strings.ToLower(string(nodes[0].Edges[0].Value)) {
+		case "secondly":
+			return atField(0, nodes[0], atNode(nodes[0].Edges[0], fmt.Errorf("%w: @secondly", ErrUnsupportedSeconds)))
+		case "every":
+			if len(nodes[0].Edges) != everyEdges {
+				return nil
+			}
+
+			// input has already been validated, the duration string is well-formed
+			if dur, err := time.ParseDuration(string(nodes[0].Edges[1].Value)); err == nil && dur < minStrictInterval {
+				return atField(0, nodes[0], atNode(nodes[0].Edges[1],
+					fmt.Errorf("%w [%s]: min: %s", ErrOutOfBoundsDuration, dur, minStrictInterval),
+				))
+			}
+		}
+
+		return nil
+	default:
+		return nil
+	}
+}
+
 func validateOverride(node *parse.Node[Token, byte]) error {
 	if node.Type != TokenAt {
 		return fmt.Errorf("%w: %T -- %v", ErrInvalidNodeType, node.Type, node.Value)
 	}
 
+	if len(node.Edges) == 0 {
+		return fmt.Errorf("%w: %d", ErrInvalidNumEdges, len(node.Edges))
+	}
+
+	frequency := strings.ToLower(string(node.Edges[0].Value))
+
+	if frequency == "every" {
+		return validateEvery(node)
+	}
+
 	if len(node.Edges) != 1 {
 		return fmt.Errorf("%w: %d", ErrInvalidNumEdges, len(node.Edges))
 	}
 
-	frequency := string(node.Edges[0].Value)
+	if _, ok := builtinOverrides[frequency]; !ok {
+		return atNode(node.Edges[0], fmt.Errorf("%w: %s", ErrInvalidFrequency, frequency))
+	}
 
-	switch frequency {
-	case "yearly", "annually", "monthly", "weekly", "daily", "hourly", "reboot":
-		return nil
-	default:
-		return fmt.Errorf("%w: %s", ErrInvalidFrequency, frequency)
+	return nil
+}
+
+func validateEvery(node *parse.Node[Token, byte]) error {
+	if len(node.Edges) != everyEdges {
+		return fmt.Errorf("%w: %d", ErrInvalidNumEdges, len(node.Edges))
+	}
+
+	value := string(node.Edges[1].Value)
+
+	dur, err := time.ParseDuration(value)
+	if err != nil {
+		return atNode(node.Edges[1], fmt.Errorf("%w [%s]: %w", ErrInvalidDuration, value, err))
+	}
+
+	if dur < minInterval {
+		return atNode(node.Edges[1], fmt.Errorf("%w [%s]: min: %s", ErrOutOfBoundsDuration, dur, minInterval))
 	}
+
+	return nil
 }
 
 func validateNumber(value string, minimum, maximum int) error {
@@ -177,6 +396,21 @@ func validateNumber(value string, minimum, maximum int) error {
 	return nil
 }
 
+// validateFrequency ensures that a step value (as in `*/N` or `A/N`) is a number above zero, as a step of zero would
+// yield a schedule that never fires.
+func validateFrequency(value string) error {
+	num, err := strconv.Atoi(value)
+	if err != nil {
+		return fmt.Errorf("%w [%s]: %w", ErrUnsupportedAlphanum, value, err)
+	}
+
+	if num <= 0 {
+		return fmt.Errorf("%w [%d]: steps must be above zero", ErrInvalidFrequency, num)
+	}
+
+	return nil
+}
+
 func validateAlpha(value string, minimum, maximum int, valueList []string) error {
 	if value == "" {
 		return ErrEmptyAlphanum
@@ -186,22 +420,38 @@ func validateAlpha(value string, minimum, maximum int, valueList []string) error
 		return validateNumber(value, minimum, maximum)
 	}
 
-	value = strings.ToUpper(value)
+	if indexOfName(value, valueList) < 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidAlphanum, strings.ToUpper(value))
+	}
+
+	return nil
+}
+
+// indexOfName returns the index of the input name in the input list of abbreviated names, or -1 if not found. The
+// name is case-insensitive, and may be spelled out in full (e.g. `January` or `MONDAY`).
+func indexOfName(name string, valueList []string) int {
+	name = strings.ToUpper(name)
 
 	for i := range valueList {
-		if value == valueList[i] {
-			return nil
+		if valueList[i] == "" {
+			continue
+		}
+
+		if name == valueList[i] || name == fullNames[valueList[i]] {
+			return i
 		}
 	}
 
-	return fmt.Errorf("%w: %s", ErrInvalidAlphanum, value)
+	return -1
 }
 
+// validateSymbols validates the values of the input edges whose type is one of the input valid symbols, with the
+// input valueFunc; or with the input stepFunc for frequency values (as in `*/N` or `A/N`).
 func validateSymbols(
 	edges []*parse.Node[Token, byte],
 	maxEdges int,
 	validSymbols []Token,
-	valueFunc func(string) error,
+	valueFunc, stepFunc func(string) error,
 ) error {
 	switch {
 	case len(edges) == 0:
@@ -216,15 +466,28 @@ func validateSymbols(
 				}
 
 				if len(edges[i].Edges) != 1 {
-					return fmt.Errorf("%w: %d", ErrInvalidNumEdges, len(edges[i].Edges))
+					return atNode(edges[i], fmt.Errorf("%w: %d", ErrInvalidNumEdges, len(edges[i].Edges)))
+				}
+
+				value := edges[i].Edges[0]
+
+				if value.Type == TokenError && edges[i].Type == TokenComma && string(value.Value) == "-" {
+					return atNode(value, fmt.Errorf("%w: %q, a value counted from the end is not supported in a list",
+						ErrInvalidAlphanum, string(value.Value)))
 				}
 
-				if edges[i].Edges[0].Type == TokenError {
-					return fmt.Errorf("%w: %v -- %q", ErrInvalidAlphanum, edges[i].Edges[0].Type, string(edges[i].Edges[0].Value))
+				if value.Type == TokenError {
+					return atNode(value, fmt.Errorf("%w: %v -- %q", ErrInvalidAlphanum, value.Type, string(value.Value)))
 				}
 
-				if err := valueFunc(string(edges[i].Edges[0].Value)); err != nil {
-					return err
+				validate := valueFunc
+
+				if edges[i].Type == TokenSlash {
+					validate = stepFunc
+				}
+
+				if err := validate(string(value.Value)); err != nil {
+					return atNode(value, err)
 				}
 
 				break
@@ -235,29 +498,57 @@ func validateSymbols(
 	}
 }
 
-func validateField(node *parse.Node[Token, byte], maxEdges, minimum, maximum int, valueFunc func(string) error) error {
+// validateField validates a field's node and its symbols with the input valueFunc. Frequency values (as in `*/N` or
+// `A/N`) must be valid field values, as well as above zero.
+func validateField(node *parse.Node[Token, byte], maxEdges int, valueFunc func(string) error) error {
+	return validateFieldWithSteps(node, maxEdges, valueFunc, func(s string) error {
+		if err := valueFunc(s); err != nil {
+			return err
+		}
+
+		return validateFrequency(s)
+	})
+}
+
+// validateFieldWithSteps is like validateField, but validates the frequency values (as in `*/N` or `A/N`) with the
+// input stepFunc instead, for fields whose frequencies are not bound to their values (like the years).
+func validateFieldWithSteps(
+	node *parse.Node[Token, byte],
+	maxEdges int,
+	valueFunc, stepFunc func(string) error,
+) error {
 	switch node.Type {
 	case TokenStar:
 		// star is OK by itself -- check if there is a slash token
-		if err := validateSymbols(node.Edges, 1, []Token{TokenSlash}, valueFunc); err != nil {
+		if err := validateSymbols(node.Edges, 1, []Token{TokenSlash}, valueFunc, stepFunc); err != nil {
 			return err
 		}
 
 		return nil
 	case TokenAlphaNum:
-		err := validateNumber(string(node.Value), minimum, maximum)
+		// the value is validated with valueFunc, so that alphanumeric values (e.g. `MON` or `JAN`) are supported both
+		// by themselves and as range endpoints (e.g. `MON-FRI` or `JAN-MAR`), in the fields that allow them
+		if err := valueFunc(string(node.Value)); err != nil {
+			return atNode(node, err)
+		}
 
-		if symbolErr := validateSymbols(
-			node.Edges, maxEdges, []Token{TokenAlphaNum, TokenSlash, TokenComma, TokenDash}, valueFunc,
-		); symbolErr != nil {
-			return errors.Join(err, symbolErr)
+		if err := validateSymbols(
+			node.Edges, maxEdges, []Token{TokenAlphaNum, TokenSlash, TokenComma, TokenDash}, valueFunc, stepFunc,
+		); err != nil {
+			return err
 		}
 
 		// check the values of the symbols, if any
 		if len(node.Edges) > 0 {
 			for i := range node.Edges {
+				validate := valueFunc
+
+				if node.Edges[i].Type == TokenSlash {
+					validate = stepFunc
+				}
+
 				for idx := range node.Edges[i].Edges {
-					if err := validateField(node.Edges[i].Edges[idx], maxEdges, minimum, maximum, valueFunc); err != nil {
+					if err := validateFieldWithSteps(node.Edges[i].Edges[idx], maxEdges, validate, stepFunc); err != nil {
 						return err
 					}
 				}
@@ -266,12 +557,12 @@ func validateField(node *parse.Node[Token, byte], maxEdges, minimum, maximum int
 
 		return nil
 	default:
-		return fmt.Errorf("%w: %T -- %v", ErrInvalidNodeType, node.Type, node.Value)
+		return atNode(node, fmt.Errorf("%w: %T -- %v", ErrInvalidNodeType, node.Type, node.Value))
 	}
 }
 
 func validateSeconds(node *parse.Node[Token, byte]) error {
-	if err := validateField(node, maxSec+1, 0, maxSec, func(s string) error {
+	if err := validateField(node, maxSec+1, func(s string) error {
 		return validateNumber(s, 0, maxSec)
 	}); err != nil {
 		return fmt.Errorf("%w (%w)", err, ErrMinutes)
@@ -281,7 +572,7 @@ func validateSeconds(node *parse.Node[Token, byte]) error {
 }
 
 func validateMinutes(node *parse.Node[Token, byte]) error {
-	if err := validateField(node, maxMin+1, 0, maxMin, func(s string) error {
+	if err := validateField(node, maxMin+1, func(s string) error {
 		return validateNumber(s, 0, maxMin)
 	}); err != nil {
 		return fmt.Errorf("%w (%w)", err, ErrMinutes)
@@ -291,7 +582,7 @@ func validateMinutes(node *parse.Node[Token, byte]) error {
 }
 
 func validateHours(node *parse.Node[Token, byte]) error {
-	if err := validateField(node, maxHour+1, 0, maxHour, func(s string) error {
+	if err := validateField(node, maxHour+1, func(s string) error {
 		return validateNumber(s, 0, maxHour)
 	}); err != nil {
 		return fmt.Errorf("%w (%w)", err, ErrHours)
@@ -301,7 +592,14 @@ func validateHours(node *parse.Node[Token, byte]) error {
 }
 
 func validateMonthDays(node *parse.Node[Token, byte]) error {
-	if err := validateField(node, maxDay, 1, maxDay, func(s string) error {
+	validate := validateField
+
+	// a leading dash counts the days back from the end of the month (e.g. `-1` is the last day)
+	if node.Type == TokenDash {
+		validate = validateFromEnd
+	}
+
+	if err := validate(node, maxDay, func(s string) error {
 		return validateNumber(s, 1, maxDay)
 	}); err != nil {
 		return fmt.Errorf("%w (%w)", err, ErrMonthDays)
@@ -310,8 +608,35 @@ func validateMonthDays(node *parse.Node[Token, byte]) error {
 	return nil
 }
 
+// validateFromEnd validates a field counted back from the end of its range, like `-1`, which is a TokenDash node with a
+// single value. Only the days of the month support it.
+func validateFromEnd(node *parse.Node[Token, byte], _ int, valueFunc func(string) error) error {
+	switch len(node.Edges) {
+	case 0:
+		return atNode(node, fmt.Errorf("%w: %d", ErrInvalidNumEdges, len(node.Edges)))
+	case 1:
+	default:
+		// symbols following the value (e.g. `-1,15`) are marked as a TokenError by the parser
+		extra := node.Edges[1]
+
+		return atNode(extra, fmt.Errorf("%w: %q after a value counted from the end", ErrInvalidAlphanum, string(extra.Value)))
+	}
+
+	value := node.Edges[0]
+
+	if value.Type != TokenAlphaNum {
+		return atNode(value, fmt.Errorf("%w: %v -- %q", ErrInvalidAlphanum, value.Type, string(value.Value)))
+	}
+
+	if len(value.Edges) > 0 {
+		return atNode(value.Edges[0], fmt.Errorf("%w: %d", ErrInvalidNumEdges, len(value.Edges)))
+	}
+
+	return atNode(value, valueFunc(string(value.Value)))
+}
+
 func validateMonths(node *parse.Node[Token, byte]) error {
-	if err := validateField(node, maxMonth, 1, maxMonth, func(s string) error {
+	if err := validateField(node, maxMonth, func(s string) error {
 		return validateAlpha(s, 1, maxMonth, monthsList)
 	}); err != nil {
 		return fmt.Errorf("%w (%w)", err, ErrMonths)
@@ -321,7 +646,7 @@ func validateMonths(node *parse.Node[Token, byte]) error {
 }
 
 func validateWeekDays(node *parse.Node[Token, byte]) error {
-	if err := validateField(node, maxWeekday, 0, maxWeekday, func(s string) error {
+	if err := validateField(node, maxWeekday, func(s string) error {
 		return validateAlpha(s, 0, maxWeekday, weekdaysList)
 	}); err != nil {
 		return fmt.Errorf("%w (%w)", err, ErrWeekDays)
@@ -329,3 +654,51 @@ func validateWeekDays(node *parse.Node[Token, byte]) error {
 
 	return nil
 }
+
+// validateYears validates the years field. Its frequencies (as in `2030/2`) are an interval of years, which only needs
+// to be above zero; and since years do not wrap around, its ranges must not be inverted (as in `2030-2025`).
+func validateYears(node *parse.Node[Token, byte]) error {
+	if err := validateFieldWithSteps(node, maxYear-minYear+1, func(s string) error {
+		return validateNumber(s, minYear, maxYear)
+	}, validateFrequency); err != nil {
+		return fmt.Errorf("%w (%w)", err, ErrYears)
+	}
+
+	if err := validateAscendingRanges(node); err != nil {
+		return fmt.Errorf("%w (%w)", err, ErrYears)
+	}
+
+	return nil
+}
+
+// validateAscendingRanges ensures that the end of each range in the input node (as in `2025-2030`) is not below its
+// start, which is the node's value or the value of the comma-separated group that the range belongs to. The values are
+// expected to be already validated as numbers.
+func validateAscendingRanges(node *parse.Node[Token, byte]) error {
+	if node.Type != TokenAlphaNum {
+		return nil
+	}
+
+	from, _ := strconv.Atoi(string(node.Value))
+
+	for i := range node.Edges {
+		if len(node.Edges[i].Edges) != 1 {
+			continue
+		}
+
+		value := node.Edges[i].Edges[0]
+		v, _ := strconv.Atoi(string(value.Value))
+
+		//nolint:exhaustive // no need to check on all token types
+		switch node.Edges[i].Type {
+		case TokenComma:
+			from = v
+		case TokenDash:
+			if v < from {
+				return atNode(value, fmt.Errorf("%w [%d-%d]: the end is before the start", ErrInvalidRange, from, v))
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package cronlex
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/zalgonoise/parse"
+
+	"github.com/zalgonoise/micron/schedule/resolve"
+)
+
+// ParseWithWeekStart is like Parse, but counts the numeric values of the days-of-the-week field from the input
+// time.Weekday instead of from Sunday. For example, with a Monday week start, `0` is Monday, `1` is Tuesday and `6` is
+// Sunday, so `0-4` means Monday to Friday. Like `0`, `7` is the week start day.
+//
+// Names of the days of the week (e.g. `MON` or `FRIDAY`) are not affected, and neither are overrides (like `@weekly`,
+// which triggers on Sundays). Expressions of custom overrides registered with RegisterOverride are parsed like any
+// other cron string, so their numeric values are counted from the week start too.
+//
+// The returned Schedule always resolves days of the week as time.Weekday values, so its String representation counts
+// them from Sunday. A time.Sunday week start is the same as calling Parse, while a time.Weekday beyond time.Saturday
+// results in an ErrInvalidWeekStart error.
+func ParseWithWeekStart(cron string, start time.Weekday) (Schedule, error) {
+	if start < time.Sunday || start > time.Saturday {
+		return Schedule{}, fmt.Errorf("%w: %d", ErrInvalidWeekStart, start)
+	}
+
+	if start == time.Sunday {
+		return Parse(cron)
+	}
+
+	cron = expandOverride(cron)
+
+	if err := validateCharacters(cron); err != nil {
+		return Schedule{}, err
+	}
+
+	return parse.Run([]byte(cron), StateFunc, ParseFunc, processWithWeekStart(start))
+}
+
+// ParseWithLocationAndWeekStart is like ParseWithLocation, counting the numeric values of the days-of-the-week field
+// from the input time.Weekday as described in ParseWithWeekStart.
+func ParseWithLocationAndWeekStart(cron string, start time.Weekday) (Schedule, *time.Location, error) {
+	return parseWithLocation(cron, func(cron string) (Schedule, error) {
+		return ParseWithWeekStart(cron, start)
+	})
+}
+
+// processWithWeekStart returns a process function like ProcessFunc, which counts the numeric values of the
+// days-of-the-week field from the input time.Weekday.
+//
+// The names in the field are first converted into values counted from the week start, so that the whole field is built
+// as usual in that space, before shifting the resulting Resolver back into time.Weekday values.
+func processWithWeekStart(start time.Weekday) func(t *parse.Tree[Token, byte]) (Schedule, error) {
+	return func(t *parse.Tree[Token, byte]) (Schedule, error) {
+		if err := Validate(t); err != nil {
+			return Schedule{}, err
+		}
+
+		nodes := t.List()
+
+		if len(nodes) == override {
+			return buildSchedule(nodes), nil
+		}
+
+		// the days of the week are the fifth field, or the sixth one if the cron string has seconds
+		if len(nodes) == noSeconds {
+			relativeWeekdayNames(nodes[4], start)
+		} else {
+			relativeWeekdayNames(nodes[5], start)
+		}
+
+		s := buildSchedule(nodes)
+		s.DayWeek = shiftWeekdays(s.DayWeek, start)
+
+		return s, nil
+	}
+}
+
+// relativeWeekdayNames replaces the names of the days of the week in the input node and its range and list values with
+// their number counted from the input week start. Frequencies are kept as-is.
+func relativeWeekdayNames(node *parse.Node[Token, byte], start time.Weekday) {
+	if node.Type == TokenAlphaNum {
+		relativeWeekdayName(node, start)
+	}
+
+	for i := range node.Edges {
+		if node.Edges[i].Type == TokenSlash {
+			continue
+		}
+
+		for idx := range node.Edges[i].Edges {
+			relativeWeekdayName(node.Edges[i].Edges[idx], start)
+		}
+	}
+}
+
+func relativeWeekdayName(node *parse.Node[Token, byte], start time.Weekday) {
+	if len(node.Value) == 0 || (node.Value[0] >= '0' && node.Value[0] <= '9') {
+		return
+	}
+
+	// input has already been validated, there will be a match
+	day := indexOfName(string(node.Value), weekdaysList)
+
+	node.Value = []byte(strconv.Itoa((day - int(start) + daysInWeek) % daysInWeek))
+}
+
+// shiftWeekdays converts the values of the input days-of-the-week Resolver, counted from the input week start, into
+// time.Weekday values.
+func shiftWeekdays(r Resolver, start time.Weekday) Resolver {
+	shift := func(value int) int {
+		return (value + int(start)) % daysInWeek
+	}
+
+	switch v := r.(type) {
+	case resolve.FixedSchedule:
+		v.At = shift(v.At)
+
+		return v
+	case resolve.RangeSchedule:
+		// a range may end up wrapping around the week (e.g. 5-6 from Monday is Saturday to Sunday, or 6-0)
+		v.From, v.To = shift(v.From), shift(v.To)
+
+		return v
+	case resolve.StepSchedule:
+		steps := make([]int, 0, len(v.Steps))
+
+		for i := range v.Steps {
+			steps = append(steps, shift(v.Steps[i]))
+		}
+
+		slices.Sort(steps)
+		v.Steps = slices.Compact(steps)
+
+		return v
+	default:
+		return r
+	}
+}
@@ -0,0 +1,140 @@
+package cronlex
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zalgonoise/x/is"
+)
+
+func TestParseWithWeekStart(t *testing.T) {
+	for _, testcase := range []struct {
+		name  string
+		cron  string
+		start time.Weekday
+		wants string
+		err   error
+	}{
+		{
+			name:  "Sunday/SameAsParse",
+			cron:  "0 9 * * 1-5",
+			start: time.Sunday,
+			wants: "0 9 * * 1-5",
+		},
+		{
+			name:  "Monday/Range",
+			cron:  "0 9 * * 0-4",
+			start: time.Monday,
+			wants: "0 9 * * 1-5",
+		},
+		{
+			name:  "Monday/LastDay",
+			cron:  "0 9 * * 6",
+			start: time.Monday,
+			wants: "0 9 * * 0",
+		},
+		{
+			name:  "Monday/ExtraWeekStart",
+			cron:  "0 9 * * 7",
+			start: time.Monday,
+			wants: "0 9 * * 1",
+		},
+		{
+			name:  "Monday/WrappingRange",
+			cron:  "0 9 * * 5-6",
+			start: time.Monday,
+			wants: "0 9 * * 0,6",
+		},
+		{
+			name:  "Monday/WholeWeek",
+			cron:  "0 9 * * 0-7",
+			start: time.Monday,
+			wants: "0 9 * * *",
+		},
+		{
+			name:  "Monday/StarFrequency",
+			cron:  "0 9 * * */2",
+			start: time.Monday,
+			wants: "0 9 * * 0,1,3,5",
+		},
+		{
+			name:  "Monday/Names",
+			cron:  "0 9 * * MON-FRI",
+			start: time.Monday,
+			wants: "0 9 * * 1-5",
+		},
+		{
+			name:  "Monday/NameAsRangeEnd",
+			cron:  "0 9 * * 4-SUN",
+			start: time.Monday,
+			wants: "0 9 * * 5,6,0",
+		},
+		{
+			name:  "Saturday/MixedList",
+			cron:  "0 9 * * 1,SAT",
+			start: time.Saturday,
+			wants: "0 9 * * 0,6",
+		},
+		{
+			name:  "Monday/WithSeconds",
+			cron:  "30 0 9 * * 0",
+			start: time.Monday,
+			wants: "30 0 9 * * 1",
+		},
+		{
+			name:  "Monday/WithYears",
+			cron:  "0 0 9 * * 0 2030",
+			start: time.Monday,
+			wants: "0 0 9 * * 1 2030",
+		},
+		{
+			name:  "Monday/OverrideUnchanged",
+			cron:  "@weekly",
+			start: time.Monday,
+			wants: "@weekly",
+		},
+		{
+			name:  "Fail/InvalidWeekStart",
+			cron:  "0 9 * * 0",
+			start: time.Weekday(7),
+			err:   ErrInvalidWeekStart,
+		},
+		{
+			name:  "Fail/InvalidCron",
+			cron:  "0 9 * * 8",
+			start: time.Monday,
+			err:   ErrOutOfBoundsAlphanum,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			s, err := ParseWithWeekStart(testcase.cron, testcase.start)
+			if testcase.err != nil {
+				is.True(t, errors.Is(err, testcase.err))
+
+				return
+			}
+
+			is.Empty(t, err)
+
+			wants, err := Parse(testcase.wants)
+			is.Empty(t, err)
+
+			is.True(t, wants.Equal(s))
+		})
+	}
+}
+
+func TestParseWithLocationAndWeekStart(t *testing.T) {
+	s, loc, err := ParseWithLocationAndWeekStart("CRON_TZ=Europe/Lisbon 0 9 * * 0-4", time.Monday)
+	is.Empty(t, err)
+	is.Equal(t, "Europe/Lisbon", loc.String())
+
+	wants, err := Parse("0 9 * * 1-5")
+	is.Empty(t, err)
+	is.True(t, wants.Equal(s))
+
+	_, loc, err = ParseWithLocationAndWeekStart("0 9 * * 0-4", time.Monday)
+	is.Empty(t, err)
+	is.True(t, loc == nil)
+}
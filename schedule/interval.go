@@ -0,0 +1,146 @@
+package schedule
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// IntervalSchedule is an implementation of Scheduler that triggers on a fixed interval, as defined by an
+// `@every <duration>` cron string (e.g. `@every 90s`).
+//
+// Unlike CronSchedule, the following occurrence is not aligned to any wall-clock field by default; instead it is
+// calculated by advancing the input time by the configured interval, on the first call to Next. From that point
+// onwards, each occurrence is anchored on the previous one: calls to Next return the same pending occurrence until it
+// is reached, and the following one is the pending occurrence advanced by the interval. If Aligned is set, occurrences
+// snap to the interval's boundaries on the wall clock instead (see WithAlignment).
+type IntervalSchedule struct {
+	// Loc will localize the times to a certain region or geolocation.
+	Loc *time.Location
+	// Every describes the fixed interval between occurrences.
+	Every time.Duration
+	// Aligned snaps the occurrences to multiples of Every on Loc's wall clock, instead of offsetting them from the
+	// input time.
+	Aligned bool
+
+	mu sync.Mutex
+	at time.Time
+
+	clock Clock
+
+	logger  *slog.Logger
+	metrics Metrics
+	tracer  trace.Tracer
+}
+
+// Next calculates and returns the following scheduled time, from the input time.Time.
+func (s *IntervalSchedule) Next(ctx context.Context, t time.Time) time.Time {
+	ctx, span := s.tracer.Start(ctx, "Scheduler.Next")
+	defer span.End()
+
+	s.metrics.IncSchedulerNextCalls()
+
+	next := resolveTime(s.clock, t).In(s.Loc)
+
+	if s.Aligned {
+		next = s.boundary(next).Add(s.Every)
+	} else {
+		next = s.anchored(next)
+	}
+
+	span.SetAttributes(attribute.String("at", next.Format(time.RFC3339)))
+	s.logger.InfoContext(ctx, "next job", slog.Time("at", next), slog.String("location", s.Loc.String()))
+
+	return next
+}
+
+// Until returns the duration from the input time.Time to the following scheduled time, which is always the configured
+// interval unless the IntervalSchedule is Aligned.
+func (s *IntervalSchedule) Until(ctx context.Context, t time.Time) time.Duration {
+	return until(ctx, s, resolveTime(s.clock, t))
+}
+
+// Prev calculates and returns the previous scheduled time, from the input time.Time.
+//
+// Unless the IntervalSchedule is Aligned, this is the most recent occurrence at or before the input time, anchored on
+// the pending occurrence (as returned by Next) and spaced by the configured interval; or the input time rewound by the
+// configured interval, if Next was not called yet. Otherwise, it is the most recent boundary of the interval, at or
+// before the input time.
+func (s *IntervalSchedule) Prev(ctx context.Context, t time.Time) time.Time {
+	ctx, span := s.tracer.Start(ctx, "Scheduler.Prev")
+	defer span.End()
+
+	prev := resolveTime(s.clock, t).In(s.Loc)
+
+	if s.Aligned {
+		prev = s.boundary(prev)
+	} else {
+		prev = s.anchoredPrev(prev)
+	}
+
+	span.SetAttributes(attribute.String("at", prev.Format(time.RFC3339)))
+	s.logger.InfoContext(ctx, "previous job", slog.Time("at", prev))
+
+	return prev
+}
+
+// anchored returns the following occurrence from the input time.Time, anchored on the pending occurrence:
+//   - while the input time is within one interval before the pending occurrence, the pending occurrence is returned,
+//     so that repeated calls (e.g. from a polling selector) do not push it further away.
+//   - once the pending occurrence is reached, the following one is the pending occurrence advanced by the interval,
+//     so that occurrences do not drift with the time it takes to call Next.
+//   - otherwise (e.g. on the first call, or after a gap of more than one interval), the input time is advanced by
+//     the interval.
+func (s *IntervalSchedule) anchored(t time.Time) time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case s.at.IsZero():
+		s.at = t.Add(s.Every)
+	case t.Before(s.at) && s.at.Sub(t) <= s.Every:
+	case !t.Before(s.at) && t.Sub(s.at) < s.Every:
+		s.at = s.at.Add(s.Every)
+	default:
+		s.at = t.Add(s.Every)
+	}
+
+	return s.at.In(s.Loc)
+}
+
+// anchoredPrev returns the most recent occurrence at or before the input time.Time, on the grid of the pending
+// occurrence (at a whole number of intervals from it). If there is no pending occurrence yet, the input time rewound by
+// the interval is returned.
+func (s *IntervalSchedule) anchoredPrev(t time.Time) time.Time {
+	s.mu.Lock()
+	at := s.at
+	s.mu.Unlock()
+
+	if at.IsZero() {
+		return t.Add(-s.Every)
+	}
+
+	diff := t.Sub(at)
+	steps := diff / s.Every
+
+	// round towards the past when the input time is before the pending occurrence
+	if diff%s.Every < 0 {
+		steps--
+	}
+
+	return at.Add(steps * s.Every).In(s.Loc)
+}
+
+// boundary returns the most recent multiple of the interval at or before the input time.Time, on the wall clock of its
+// location. Since time.Time.Truncate rounds down in absolute time, the time.Time is shifted by its zone offset so that
+// boundaries fall on the location's wall clock (e.g. on the hour for `@every 1h` in a UTC+05:30 location).
+func (s *IntervalSchedule) boundary(t time.Time) time.Time {
+	_, offset := t.Zone()
+	shift := time.Duration(offset) * time.Second
+
+	return t.Add(shift).Truncate(s.Every).Add(-shift)
+}
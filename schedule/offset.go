@@ -0,0 +1,100 @@
+package schedule
+
+import (
+	"context"
+	"time"
+)
+
+// OffsetScheduler is a Scheduler that shifts the occurrences of another Scheduler by a fixed offset, for jobs that must
+// run a certain duration before or after a base schedule (e.g. five minutes after an hourly job).
+//
+// A positive Offset delays the occurrences, while a negative Offset brings them forward.
+type OffsetScheduler struct {
+	// Scheduler is the base Scheduler whose occurrences are shifted.
+	Scheduler Scheduler
+	// Offset is the duration added to each of the base Scheduler's occurrences.
+	Offset time.Duration
+}
+
+// Offset wraps the input Scheduler in an OffsetScheduler, shifting each of its occurrences by the input offset.
+//
+// If the input Scheduler is nil or a no-op Scheduler, a no-op Scheduler is returned. If the offset is zero, the
+// Scheduler is returned as-is.
+func Offset(s Scheduler, offset time.Duration) Scheduler {
+	if s == nil || s == NoOp() {
+		return NoOp()
+	}
+
+	if offset == 0 {
+		return s
+	}
+
+	return &OffsetScheduler{
+		Scheduler: s,
+		Offset:    offset,
+	}
+}
+
+// Next calculates and returns the following scheduled time, from the input time.Time.
+//
+// This is the base Scheduler's following occurrence in the context of the input time.Time minus the Offset, plus the
+// Offset; so that an occurrence that is due for the base Scheduler, but not yet shifted, is not skipped. A zero
+// time.Time is returned when the base Scheduler has no further occurrences.
+func (s *OffsetScheduler) Next(ctx context.Context, t time.Time) time.Time {
+	next := s.Scheduler.Next(ctx, s.resolve(t).Add(-s.Offset))
+	if next.IsZero() {
+		return next
+	}
+
+	return next.Add(s.Offset)
+}
+
+// Prev calculates and returns the most recent scheduled time, at or before the input time.Time, as the base
+// Scheduler's most recent occurrence shifted by the Offset.
+func (s *OffsetScheduler) Prev(ctx context.Context, t time.Time) time.Time {
+	prev := s.Scheduler.Prev(ctx, s.resolve(t).Add(-s.Offset))
+	if prev.IsZero() {
+		return prev
+	}
+
+	return prev.Add(s.Offset)
+}
+
+// Until returns the duration from the input time.Time to the following scheduled time, as returned by Next.
+//
+// A zero or negative duration means that the schedule is due. If the base Scheduler never triggers again, Never is
+// returned.
+func (s *OffsetScheduler) Until(ctx context.Context, t time.Time) time.Duration {
+	return until(ctx, s, s.resolve(t))
+}
+
+// Consume marks the input occurrence as spent, if the base Scheduler is a Consumer, by consuming the base Scheduler's
+// occurrence that it is shifted from.
+func (s *OffsetScheduler) Consume(at time.Time) {
+	if c, ok := s.Scheduler.(Consumer); ok {
+		c.Consume(at.Add(-s.Offset))
+	}
+}
+
+// resolve returns the input time.Time, or the current time if it is zero. The current time is taken from the base
+// Scheduler's Clock, if it is one of this package's Scheduler implementations.
+func (s *OffsetScheduler) resolve(t time.Time) time.Time {
+	return resolveTime(clockOf(s.Scheduler), t)
+}
+
+// clockOf returns the Clock configured in the input Scheduler, or nil if it is not one of this package's Scheduler
+// implementations.
+func clockOf(s Scheduler) Clock {
+	switch sched := s.(type) {
+	case *CronSchedule:
+		return sched.clock
+	case *IntervalSchedule:
+		return sched.clock
+	case *OnceSchedule:
+		return sched.clock
+	case *OffsetScheduler:
+		return clockOf(sched.Scheduler)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,107 @@
+package schedule
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// OnceSchedule is an implementation of Scheduler that triggers a single time, as defined by an `@reboot` cron string.
+//
+// The occurrence is set on the first call to Next, as the following full second from the input time. Any calls to Next
+// from that point onwards return the same occurrence, even once it is due, until it is consumed by its caller's run
+// (see Consume); after which the zero time.Time is returned, signaling that the schedule has no further occurrences.
+// This way, querying the schedule (e.g. for display) does not spend the occurrence before it runs.
+type OnceSchedule struct {
+	// Loc will localize the times to a certain region or geolocation.
+	Loc *time.Location
+
+	mu       sync.Mutex
+	at       time.Time
+	consumed bool
+
+	clock Clock
+
+	logger  *slog.Logger
+	metrics Metrics
+	tracer  trace.Tracer
+}
+
+// Next calculates and returns the following scheduled time, from the input time.Time.
+//
+// The single occurrence of this schedule is returned until it is consumed (see Consume), even if it is before the input
+// time.Time, as it is still due. Once consumed, the zero time.Time is returned.
+func (s *OnceSchedule) Next(ctx context.Context, t time.Time) time.Time {
+	ctx, span := s.tracer.Start(ctx, "Scheduler.Next")
+	defer span.End()
+
+	s.metrics.IncSchedulerNextCalls()
+
+	t = resolveTime(s.clock, t).In(s.Loc)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.consumed {
+		span.SetAttributes(attribute.Bool("no_occurrence", true))
+		s.logger.InfoContext(ctx, "single occurrence already consumed")
+
+		return time.Time{}
+	}
+
+	if s.at.IsZero() {
+		s.at = t.Truncate(time.Second).Add(time.Second)
+	}
+
+	span.SetAttributes(attribute.String("at", s.at.Format(time.RFC3339)))
+	s.logger.InfoContext(ctx, "next job", slog.Time("at", s.at), slog.String("location", s.Loc.String()))
+
+	return s.at
+}
+
+// Until returns the duration from the input time.Time to the single occurrence of this schedule, as returned by Next.
+//
+// Once the single occurrence of this schedule is consumed, Never is returned.
+func (s *OnceSchedule) Until(ctx context.Context, t time.Time) time.Duration {
+	return until(ctx, s, resolveTime(s.clock, t))
+}
+
+// Prev calculates and returns the most recent scheduled time, at or before the input time.Time.
+//
+// This is the single occurrence of this schedule once it has been reached, otherwise the zero time.Time is returned.
+func (s *OnceSchedule) Prev(ctx context.Context, t time.Time) time.Time {
+	ctx, span := s.tracer.Start(ctx, "Scheduler.Prev")
+	defer span.End()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t = resolveTime(s.clock, t)
+
+	if s.at.IsZero() || t.Before(s.at) {
+		span.SetAttributes(attribute.Bool("no_occurrence", true))
+		s.logger.InfoContext(ctx, "single occurrence not yet reached")
+
+		return time.Time{}
+	}
+
+	span.SetAttributes(attribute.String("at", s.at.Format(time.RFC3339)))
+	s.logger.InfoContext(ctx, "previous job", slog.Time("at", s.at))
+
+	return s.at
+}
+
+// Consume marks the single occurrence of this schedule as spent, if it is the input time.Time, so that Next no longer
+// returns it. It is called by the executor.Executable once it runs the occurrence.
+func (s *OnceSchedule) Consume(at time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.at.IsZero() && s.at.Equal(at) {
+		s.consumed = true
+	}
+}
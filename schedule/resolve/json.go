@@ -0,0 +1,213 @@
+package resolve
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/zalgonoise/x/errs"
+)
+
+// Type tags identifying each resolver in its JSON representation.
+const (
+	TypeEverytime = "everytime"
+	TypeFixed     = "fixed"
+	TypeRange     = "range"
+	TypeStep      = "step"
+	TypeYear      = "year"
+	TypeFromEnd   = "from_end"
+)
+
+const (
+	errDomain = errs.Domain("micron/schedule/resolve")
+
+	ErrInvalid = errs.Kind("invalid")
+
+	ErrType    = errs.Entity("resolver type")
+	ErrMaximum = errs.Entity("maximum value")
+	ErrBounds  = errs.Entity("value bounds")
+)
+
+var (
+	ErrInvalidType    = errs.WithDomain(errDomain, ErrInvalid, ErrType)
+	ErrInvalidMaximum = errs.WithDomain(errDomain, ErrInvalid, ErrMaximum)
+	ErrOutOfBounds    = errs.WithDomain(errDomain, ErrInvalid, ErrBounds)
+)
+
+type everytimeJSON struct {
+	Type string `json:"type"`
+}
+
+type fixedJSON struct {
+	Type string `json:"type"`
+	Max  int    `json:"max"`
+	At   int    `json:"at"`
+}
+
+type rangeJSON struct {
+	Type string `json:"type"`
+	Max  int    `json:"max"`
+	From int    `json:"from"`
+	To   int    `json:"to"`
+}
+
+type stepJSON struct {
+	Type  string `json:"type"`
+	Max   int    `json:"max"`
+	Steps []int  `json:"steps"`
+}
+
+type yearJSON struct {
+	Type  string `json:"type"`
+	Years []int  `json:"years"`
+}
+
+type fromEndJSON struct {
+	Type    string `json:"type"`
+	Max     int    `json:"max"`
+	FromEnd int    `json:"from_end"`
+}
+
+// TypeOf returns the type tag of the JSON-encoded resolver in the input data.
+func TypeOf(data []byte) (string, error) {
+	var v everytimeJSON
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return "", err
+	}
+
+	return v.Type, nil
+}
+
+func checkType(wants, got string) error {
+	if got != wants {
+		return fmt.Errorf("%w: expected %q, got %q", ErrInvalidType, wants, got)
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes the Everytime resolver as JSON, tagged with its type.
+func (s Everytime) MarshalJSON() ([]byte, error) {
+	return json.Marshal(everytimeJSON{Type: TypeEverytime})
+}
+
+// UnmarshalJSON decodes a JSON-encoded Everytime resolver, returning an error if the type tag does not match.
+func (s *Everytime) UnmarshalJSON(data []byte) error {
+	var v everytimeJSON
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	return checkType(TypeEverytime, v.Type)
+}
+
+// MarshalJSON encodes the FixedSchedule resolver as JSON, tagged with its type.
+func (s FixedSchedule) MarshalJSON() ([]byte, error) {
+	return json.Marshal(fixedJSON{Type: TypeFixed, Max: s.Max, At: s.At})
+}
+
+// UnmarshalJSON decodes a JSON-encoded FixedSchedule resolver, returning an error if the type tag does not match.
+func (s *FixedSchedule) UnmarshalJSON(data []byte) error {
+	var v fixedJSON
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	if err := checkType(TypeFixed, v.Type); err != nil {
+		return err
+	}
+
+	s.Max, s.At = v.Max, v.At
+
+	return nil
+}
+
+// MarshalJSON encodes the RangeSchedule resolver as JSON, tagged with its type.
+func (s RangeSchedule) MarshalJSON() ([]byte, error) {
+	return json.Marshal(rangeJSON{Type: TypeRange, Max: s.Max, From: s.From, To: s.To})
+}
+
+// UnmarshalJSON decodes a JSON-encoded RangeSchedule resolver, returning an error if the type tag does not match.
+func (s *RangeSchedule) UnmarshalJSON(data []byte) error {
+	var v rangeJSON
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	if err := checkType(TypeRange, v.Type); err != nil {
+		return err
+	}
+
+	s.Max, s.From, s.To = v.Max, v.From, v.To
+
+	return nil
+}
+
+// MarshalJSON encodes the StepSchedule resolver as JSON, tagged with its type.
+func (s StepSchedule) MarshalJSON() ([]byte, error) {
+	return json.Marshal(stepJSON{Type: TypeStep, Max: s.Max, Steps: s.Steps})
+}
+
+// UnmarshalJSON decodes a JSON-encoded StepSchedule resolver, returning an error if the type tag does not match.
+func (s *StepSchedule) UnmarshalJSON(data []byte) error {
+	var v stepJSON
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	if err := checkType(TypeStep, v.Type); err != nil {
+		return err
+	}
+
+	s.Max, s.Steps = v.Max, v.Steps
+
+	return nil
+}
+
+// MarshalJSON encodes the YearSchedule resolver as JSON, tagged with its type.
+func (s YearSchedule) MarshalJSON() ([]byte, error) {
+	return json.Marshal(yearJSON{Type: TypeYear, Years: s.Years})
+}
+
+// UnmarshalJSON decodes a JSON-encoded YearSchedule resolver, returning an error if the type tag does not match.
+func (s *YearSchedule) UnmarshalJSON(data []byte) error {
+	var v yearJSON
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	if err := checkType(TypeYear, v.Type); err != nil {
+		return err
+	}
+
+	s.Years = v.Years
+
+	return nil
+}
+
+// MarshalJSON encodes the FromEndSchedule resolver as JSON, tagged with its type.
+func (s FromEndSchedule) MarshalJSON() ([]byte, error) {
+	return json.Marshal(fromEndJSON{Type: TypeFromEnd, Max: s.Max, FromEnd: s.FromEnd})
+}
+
+// UnmarshalJSON decodes a JSON-encoded FromEndSchedule resolver, returning an error if the type tag does not match.
+func (s *FromEndSchedule) UnmarshalJSON(data []byte) error {
+	var v fromEndJSON
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	if err := checkType(TypeFromEnd, v.Type); err != nil {
+		return err
+	}
+
+	s.Max, s.FromEnd = v.Max, v.FromEnd
+
+	return nil
+}
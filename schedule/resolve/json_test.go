@@ -0,0 +1,95 @@
+package resolve
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zalgonoise/x/is"
+)
+
+func TestJSON(t *testing.T) {
+	for _, testcase := range []struct {
+		name     string
+		resolver any
+		decoded  any
+		wants    string
+	}{
+		{
+			name:     "Everytime",
+			resolver: Everytime{},
+			decoded:  &Everytime{},
+			wants:    `{"type":"everytime"}`,
+		},
+		{
+			name:     "FixedSchedule",
+			resolver: FixedSchedule{Max: 59, At: 0},
+			decoded:  &FixedSchedule{},
+			wants:    `{"type":"fixed","max":59,"at":0}`,
+		},
+		{
+			name:     "RangeSchedule",
+			resolver: RangeSchedule{Max: 23, From: 22, To: 2},
+			decoded:  &RangeSchedule{},
+			wants:    `{"type":"range","max":23,"from":22,"to":2}`,
+		},
+		{
+			name:     "StepSchedule",
+			resolver: StepSchedule{Max: 59, Steps: []int{0, 30}},
+			decoded:  &StepSchedule{},
+			wants:    `{"type":"step","max":59,"steps":[0,30]}`,
+		},
+		{
+			name:     "YearSchedule",
+			resolver: YearSchedule{Years: []int{2025, 2026}},
+			decoded:  &YearSchedule{},
+			wants:    `{"type":"year","years":[2025,2026]}`,
+		},
+		{
+			name:     "FromEndSchedule",
+			resolver: FromEndSchedule{Max: 31, FromEnd: 2},
+			decoded:  &FromEndSchedule{},
+			wants:    `{"type":"from_end","max":31,"from_end":2}`,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			data, err := json.Marshal(testcase.resolver)
+			is.Empty(t, err)
+			is.Equal(t, testcase.wants, string(data))
+
+			typ, err := TypeOf(data)
+			is.Empty(t, err)
+			is.True(t, typ != "")
+
+			is.Empty(t, json.Unmarshal(data, testcase.decoded))
+			require.Equal(t, testcase.resolver, deref(testcase.decoded))
+		})
+	}
+}
+
+func TestUnmarshalJSON_TypeMismatch(t *testing.T) {
+	var s FixedSchedule
+
+	err := json.Unmarshal([]byte(`{"type":"range","max":23,"from":1,"to":2}`), &s)
+	is.True(t, errors.Is(err, ErrInvalidType))
+}
+
+func deref(v any) any {
+	switch r := v.(type) {
+	case *Everytime:
+		return *r
+	case *FixedSchedule:
+		return *r
+	case *RangeSchedule:
+		return *r
+	case *StepSchedule:
+		return *r
+	case *YearSchedule:
+		return *r
+	case *FromEndSchedule:
+		return *r
+	default:
+		return v
+	}
+}
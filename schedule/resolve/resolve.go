@@ -1,5 +1,15 @@
 package resolve
 
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// NoOccurrence is a sentinel value returned by a resolver when no further occurrences are possible
+// (e.g. when a year has already passed).
+const NoOccurrence = -1
+
 // Everytime always resolves to zero, as a constantly occurring resolver.
 type Everytime struct{}
 
@@ -8,6 +18,21 @@ func (s Everytime) Resolve(_ int) int {
 	return 0
 }
 
+// ResolvePrev returns the distance to the previous occurrence, as unit values.
+func (s Everytime) ResolvePrev(_ int) int {
+	return 0
+}
+
+// Contains returns true if the input value is an occurrence, which is always the case.
+func (s Everytime) Contains(_ int) bool {
+	return true
+}
+
+// Equal returns true if the input Everytime is the same resolver, which is always the case.
+func (s Everytime) Equal(_ Everytime) bool {
+	return true
+}
+
 // FixedSchedule resolves on a specific value, described as At. It also stores Max to delimit the maximum range for
 // this resolver.
 type FixedSchedule struct {
@@ -15,30 +40,149 @@ type FixedSchedule struct {
 	At  int
 }
 
+// NewFixedSchedule creates a FixedSchedule resolving on the value at, within the resolver's maximum value.
+//
+// It returns an ErrInvalidMaximum error if maximum is not positive, and an ErrOutOfBounds error if at is negative or
+// greater than maximum.
+func NewFixedSchedule(at, maximum int) (FixedSchedule, error) {
+	if maximum <= 0 {
+		return FixedSchedule{}, fmt.Errorf("%w: %d", ErrInvalidMaximum, maximum)
+	}
+
+	if at < 0 || at > maximum {
+		return FixedSchedule{}, fmt.Errorf("%w: at: %d; max: %d", ErrOutOfBounds, at, maximum)
+	}
+
+	return FixedSchedule{Max: maximum, At: at}, nil
+}
+
 // Resolve returns the distance to the next occurrence, as unit values.
 func (s FixedSchedule) Resolve(value int) int {
 	return diff(value, s.At, s.At, s.Max)
 }
 
+// ResolvePrev returns the distance to the previous occurrence, as unit values.
+func (s FixedSchedule) ResolvePrev(value int) int {
+	return diffPrev(value, s.At, s.At, s.Max)
+}
+
+// Contains returns true if the input value is an occurrence.
+func (s FixedSchedule) Contains(value int) bool {
+	return value == s.At
+}
+
+// Expand returns the complete set of values that the FixedSchedule resolves on, which is only At.
+func (s FixedSchedule) Expand() []int {
+	return []int{s.At}
+}
+
+// Equal returns true if the input FixedSchedule resolves on the same value, within the same maximum.
+func (s FixedSchedule) Equal(other FixedSchedule) bool {
+	return s == other
+}
+
 // RangeSchedule resolves on every value between From and To. It also stores Max to delimit the maximum range for
 // this resolver.
+//
+// If From is greater than To, the range wraps around the maximum value (e.g. hours 22-2 match 22, 23, 0, 1 and 2).
 type RangeSchedule struct {
 	Max  int
 	From int
 	To   int
 }
 
+// NewRangeSchedule creates a RangeSchedule resolving on every value between from and to, within the resolver's maximum
+// value.
+//
+// A from value greater than to is a valid, wrapping range (e.g. hours 22-2); both delimiters must still be within
+// bounds, as a wrapping range is only expressed by its order. It returns an ErrInvalidMaximum error if maximum is not
+// positive, and an ErrOutOfBounds error if either delimiter is negative or greater than maximum.
+func NewRangeSchedule(from, to, maximum int) (RangeSchedule, error) {
+	if maximum <= 0 {
+		return RangeSchedule{}, fmt.Errorf("%w: %d", ErrInvalidMaximum, maximum)
+	}
+
+	var err error
+
+	if from < 0 || from > maximum {
+		err = fmt.Errorf("%w: from: %d; max: %d", ErrOutOfBounds, from, maximum)
+	}
+
+	if to < 0 || to > maximum {
+		err = errors.Join(err, fmt.Errorf("%w: to: %d; max: %d", ErrOutOfBounds, to, maximum))
+	}
+
+	if err != nil {
+		return RangeSchedule{}, err
+	}
+
+	return RangeSchedule{Max: maximum, From: from, To: to}, nil
+}
+
 // Resolve returns the distance to the next occurrence, as unit values.
 func (s RangeSchedule) Resolve(value int) int {
-	if value > s.From && value < s.To {
+	if s.From > s.To {
+		if value >= s.From || value <= s.To {
+			return 0
+		}
+
+		// the value is between the end and the start of the wrapping range
+		return s.From - value
+	}
+
+	if value >= s.From && value <= s.To {
 		return 0
 	}
 
 	return diff(value, s.From, s.To, s.Max)
 }
 
+// ResolvePrev returns the distance to the previous occurrence, as unit values.
+func (s RangeSchedule) ResolvePrev(value int) int {
+	if s.From > s.To {
+		if value >= s.From || value <= s.To {
+			return 0
+		}
+
+		// the value is between the end and the start of the wrapping range
+		return value - s.To
+	}
+
+	if value >= s.From && value <= s.To {
+		return 0
+	}
+
+	return diffPrev(value, s.From, s.To, s.Max)
+}
+
+// Contains returns true if the input value is an occurrence.
+func (s RangeSchedule) Contains(value int) bool {
+	if s.From > s.To {
+		return value >= s.From || value <= s.To
+	}
+
+	return value >= s.From && value <= s.To
+}
+
+// Expand returns the complete set of values that the RangeSchedule resolves on, in ascending order. A wrapping range
+// returns the values from zero up to To, followed by the values from From up to Max.
+func (s RangeSchedule) Expand() []int {
+	if s.From > s.To {
+		return append(newValueRange(0, s.To, 1), newValueRange(s.From, s.Max, 1)...)
+	}
+
+	return newValueRange(s.From, s.To, 1)
+}
+
+// Equal returns true if the input RangeSchedule resolves on the same range, within the same maximum.
+func (s RangeSchedule) Equal(other RangeSchedule) bool {
+	return s == other
+}
+
 // StepSchedule resolves on specific values listed in Steps. It also stores Max to delimit the maximum range for
 // this resolver.
+//
+// Steps must be sorted in ascending order, as the resolver binary-searches them for the closest occurrence.
 type StepSchedule struct {
 	Max   int
 	Steps []int
@@ -46,31 +190,143 @@ type StepSchedule struct {
 
 // Resolve returns the distance to the next occurrence, as unit values.
 func (s StepSchedule) Resolve(value int) int {
-	offset := -1
+	if len(s.Steps) == 0 {
+		return -1
+	}
 
-	for i := range s.Steps {
-		if offset == -1 {
-			offset = diff(value, s.Steps[i], s.Steps[i], s.Max)
+	// the first step at or after the value is the closest one; otherwise wrap around into the first step
+	if idx, _ := slices.BinarySearch(s.Steps, value); idx < len(s.Steps) {
+		return diff(value, s.Steps[idx], s.Steps[idx], s.Max)
+	}
 
-			continue
-		}
+	return diff(value, s.Steps[0], s.Steps[0], s.Max)
+}
 
-		if n := diff(value, s.Steps[i], s.Steps[i], s.Max); n < offset {
-			offset = n
-		}
+// ResolvePrev returns the distance to the previous occurrence, as unit values.
+func (s StepSchedule) ResolvePrev(value int) int {
+	if len(s.Steps) == 0 {
+		return -1
 	}
 
-	return offset
+	// the last step at or before the value is the closest one; otherwise wrap around into the last step
+	idx, found := slices.BinarySearch(s.Steps, value)
+
+	switch {
+	case found:
+		return 0
+	case idx > 0:
+		return diffPrev(value, s.Steps[idx-1], s.Steps[idx-1], s.Max)
+	default:
+		return diffPrev(value, s.Steps[len(s.Steps)-1], s.Steps[len(s.Steps)-1], s.Max)
+	}
+}
+
+// Contains returns true if the input value is an occurrence.
+func (s StepSchedule) Contains(value int) bool {
+	_, found := slices.BinarySearch(s.Steps, value)
+
+	return found
+}
+
+// Expand returns the complete set of values that the StepSchedule resolves on, which are its Steps in their normalized
+// form (sorted and de-duplicated).
+func (s StepSchedule) Expand() []int {
+	return normalize(s.Steps)
+}
+
+// Equal returns true if the input StepSchedule resolves on the same steps, within the same maximum. Steps are compared
+// in their normalized form (sorted and de-duplicated), so a nil and an empty list of Steps are equal.
+func (s StepSchedule) Equal(other StepSchedule) bool {
+	return s.Max == other.Max && slices.Equal(normalize(s.Steps), normalize(other.Steps))
+}
+
+// FromEndSchedule resolves on a day of the month counted back from its last day, described as FromEnd: 1 is the last
+// day of the month, 2 is the day before it, and so on. It also stores Max to delimit the maximum range for this
+// resolver, which is the number of days of the longest month.
+//
+// As the day that it resolves on depends on the length of the month, schedulers should match days with ContainsDay. The
+// Resolve, ResolvePrev and Contains methods assume a month with Max days.
+type FromEndSchedule struct {
+	Max     int
+	FromEnd int
+}
+
+// NewFromEndSchedule creates a FromEndSchedule resolving on the fromEnd-th day counted back from the end of the month,
+// within the resolver's maximum value.
+//
+// It returns an ErrInvalidMaximum error if maximum is not positive, and an ErrOutOfBounds error if fromEnd is not
+// positive or is greater than maximum.
+func NewFromEndSchedule(fromEnd, maximum int) (FromEndSchedule, error) {
+	if maximum <= 0 {
+		return FromEndSchedule{}, fmt.Errorf("%w: %d", ErrInvalidMaximum, maximum)
+	}
+
+	if fromEnd <= 0 || fromEnd > maximum {
+		return FromEndSchedule{}, fmt.Errorf("%w: from end: %d; max: %d", ErrOutOfBounds, fromEnd, maximum)
+	}
+
+	return FromEndSchedule{Max: maximum, FromEnd: fromEnd}, nil
+}
+
+// Resolve returns the distance to the next occurrence, as unit values, in a month with Max days.
+func (s FromEndSchedule) Resolve(value int) int {
+	at := s.Day(s.Max)
+
+	return diff(value, at, at, s.Max)
+}
+
+// ResolvePrev returns the distance to the previous occurrence, as unit values, in a month with Max days.
+func (s FromEndSchedule) ResolvePrev(value int) int {
+	at := s.Day(s.Max)
+
+	return diffPrev(value, at, at, s.Max)
+}
+
+// Contains returns true if the input value is an occurrence, in a month with Max days.
+func (s FromEndSchedule) Contains(value int) bool {
+	return s.ContainsDay(value, s.Max)
+}
+
+// ContainsDay returns true if the input day is an occurrence, in a month with the input number of days.
+func (s FromEndSchedule) ContainsDay(day, daysInMonth int) bool {
+	return day == s.Day(daysInMonth)
+}
+
+// Day returns the day that the FromEndSchedule resolves on, in a month with the input number of days. The returned day
+// is zero or below if the month is shorter than FromEnd days, in which case it has no occurrence.
+func (s FromEndSchedule) Day(daysInMonth int) int {
+	return daysInMonth - s.FromEnd + 1
+}
+
+// Expand returns the complete set of values that the FromEndSchedule resolves on, in a month with Max days.
+func (s FromEndSchedule) Expand() []int {
+	return []int{s.Day(s.Max)}
+}
+
+// Equal returns true if the input FromEndSchedule resolves on the same day from the end of the month, within the same
+// maximum.
+func (s FromEndSchedule) Equal(other FromEndSchedule) bool {
+	return s == other
 }
 
 func diff(value, from, to, maximum int) int {
 	if value > to {
-		return from + maximum - value
+		// wrapping around into the next cycle never resolves to zero, as the value is not an occurrence
+		return max(from+maximum-value, 1)
 	}
 
 	return from - value
 }
 
+func diffPrev(value, from, to, maximum int) int {
+	if value < from {
+		// wrapping around into the previous cycle never resolves to zero, as the value is not an occurrence
+		return max(value+maximum-to, 1)
+	}
+
+	return value - to
+}
+
 // NewStepSchedule is a constructor to quickly build StepSchedule types, using key values to
 // create the steps -- using from and to delimiters as well as the resolver's maximum value, and a
 // frequency.
@@ -86,7 +342,7 @@ func newValueRange(from, to, frequency int) []int {
 		return []int{}
 	}
 
-	r := make([]int, 0, to-from/frequency)
+	r := make([]int, 0, (to-from)/frequency+1)
 
 	for i := from; i <= to; i += frequency {
 		r = append(r, i)
@@ -94,3 +350,60 @@ func newValueRange(from, to, frequency int) []int {
 
 	return r
 }
+
+// YearSchedule resolves on specific years, listed in Years. Since years do not wrap around like the other
+// schedule elements, Resolve returns NoOccurrence once all listed years are behind the input value.
+type YearSchedule struct {
+	Years []int
+}
+
+// Resolve returns the distance to the next occurrence, as unit values.
+//
+// If all configured years have already passed, NoOccurrence is returned.
+func (s YearSchedule) Resolve(value int) int {
+	for i := range s.Years {
+		if s.Years[i] >= value {
+			return s.Years[i] - value
+		}
+	}
+
+	return NoOccurrence
+}
+
+// ResolvePrev returns the distance to the previous occurrence, as unit values.
+//
+// If all configured years are still ahead of the input value, NoOccurrence is returned.
+func (s YearSchedule) ResolvePrev(value int) int {
+	for i := len(s.Years) - 1; i >= 0; i-- {
+		if s.Years[i] <= value {
+			return value - s.Years[i]
+		}
+	}
+
+	return NoOccurrence
+}
+
+// Contains returns true if the input value is an occurrence.
+func (s YearSchedule) Contains(value int) bool {
+	return slices.Contains(s.Years, value)
+}
+
+// Expand returns the complete set of years that the YearSchedule resolves on, in their normalized form (sorted and
+// de-duplicated).
+func (s YearSchedule) Expand() []int {
+	return normalize(s.Years)
+}
+
+// Equal returns true if the input YearSchedule resolves on the same years. Years are compared in their normalized form
+// (sorted and de-duplicated).
+func (s YearSchedule) Equal(other YearSchedule) bool {
+	return slices.Equal(normalize(s.Years), normalize(other.Years))
+}
+
+func normalize(values []int) []int {
+	values = slices.Clone(values)
+
+	slices.Sort(values)
+
+	return slices.Compact(values)
+}
@@ -0,0 +1,466 @@
+package resolve
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/zalgonoise/x/is"
+)
+
+func TestNewValueRange(t *testing.T) {
+	for _, testcase := range []struct {
+		name      string
+		from      int
+		to        int
+		frequency int
+		wants     []int
+	}{
+		{
+			name:      "EveryFifteenMinutes",
+			from:      0,
+			to:        59,
+			frequency: 15,
+			wants:     []int{0, 15, 30, 45},
+		},
+		{
+			name:      "EveryOtherDayFromTheFirst",
+			from:      1,
+			to:        31,
+			frequency: 2,
+			wants:     []int{1, 3, 5, 7, 9, 11, 13, 15, 17, 19, 21, 23, 25, 27, 29, 31},
+		},
+		{
+			name:      "OffsetStart",
+			from:      20,
+			to:        23,
+			frequency: 1,
+			wants:     []int{20, 21, 22, 23},
+		},
+		{
+			name:      "SingleValue",
+			from:      5,
+			to:        5,
+			frequency: 3,
+			wants:     []int{5},
+		},
+		{
+			name:      "ZeroFrequency",
+			from:      0,
+			to:        59,
+			frequency: 0,
+			wants:     []int{},
+		},
+		{
+			name:      "InvertedRange",
+			from:      10,
+			to:        2,
+			frequency: 1,
+			wants:     []int{},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			values := newValueRange(testcase.from, testcase.to, testcase.frequency)
+
+			is.EqualElements(t, testcase.wants, values)
+
+			if testcase.frequency > 0 && testcase.from <= testcase.to {
+				is.Equal(t, (testcase.to-testcase.from)/testcase.frequency+1, len(values))
+				is.Equal(t, len(values), cap(values))
+			}
+		})
+	}
+}
+
+// linearResolve is the reference, linear implementation of StepSchedule.Resolve, checking the distance to every step.
+func linearResolve(s StepSchedule, value int) int {
+	offset := -1
+
+	for i := range s.Steps {
+		if n := diff(value, s.Steps[i], s.Steps[i], s.Max); offset == -1 || n < offset {
+			offset = n
+		}
+	}
+
+	return offset
+}
+
+// linearResolvePrev is the reference, linear implementation of StepSchedule.ResolvePrev.
+func linearResolvePrev(s StepSchedule, value int) int {
+	offset := -1
+
+	for i := range s.Steps {
+		if n := diffPrev(value, s.Steps[i], s.Steps[i], s.Max); offset == -1 || n < offset {
+			offset = n
+		}
+	}
+
+	return offset
+}
+
+func TestNewFixedSchedule(t *testing.T) {
+	for _, testcase := range []struct {
+		name    string
+		at      int
+		maximum int
+		wants   FixedSchedule
+		err     error
+	}{
+		{
+			name:    "Success",
+			at:      30,
+			maximum: 59,
+			wants:   FixedSchedule{Max: 59, At: 30},
+		},
+		{
+			name:    "Success/AtMaximum",
+			at:      59,
+			maximum: 59,
+			wants:   FixedSchedule{Max: 59, At: 59},
+		},
+		{
+			name:    "Fail/AboveMaximum",
+			at:      60,
+			maximum: 59,
+			err:     ErrOutOfBounds,
+		},
+		{
+			name:    "Fail/Negative",
+			at:      -1,
+			maximum: 59,
+			err:     ErrOutOfBounds,
+		},
+		{
+			name:    "Fail/InvalidMaximum",
+			at:      0,
+			maximum: 0,
+			err:     ErrInvalidMaximum,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			s, err := NewFixedSchedule(testcase.at, testcase.maximum)
+			if testcase.err != nil {
+				is.True(t, errors.Is(err, testcase.err))
+
+				return
+			}
+
+			is.Empty(t, err)
+			is.Equal(t, testcase.wants, s)
+		})
+	}
+}
+
+func TestNewRangeSchedule(t *testing.T) {
+	for _, testcase := range []struct {
+		name    string
+		from    int
+		to      int
+		maximum int
+		wants   RangeSchedule
+		err     error
+	}{
+		{
+			name:    "Success",
+			from:    9,
+			to:      17,
+			maximum: 23,
+			wants:   RangeSchedule{Max: 23, From: 9, To: 17},
+		},
+		{
+			name:    "Success/WrapAround",
+			from:    22,
+			to:      2,
+			maximum: 23,
+			wants:   RangeSchedule{Max: 23, From: 22, To: 2},
+		},
+		{
+			name:    "Fail/FromAboveMaximum",
+			from:    24,
+			to:      2,
+			maximum: 23,
+			err:     ErrOutOfBounds,
+		},
+		{
+			name:    "Fail/ToAboveMaximum",
+			from:    9,
+			to:      24,
+			maximum: 23,
+			err:     ErrOutOfBounds,
+		},
+		{
+			name:    "Fail/Negative",
+			from:    -1,
+			to:      2,
+			maximum: 23,
+			err:     ErrOutOfBounds,
+		},
+		{
+			name:    "Fail/InvalidMaximum",
+			from:    0,
+			to:      2,
+			maximum: -1,
+			err:     ErrInvalidMaximum,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			s, err := NewRangeSchedule(testcase.from, testcase.to, testcase.maximum)
+			if testcase.err != nil {
+				is.True(t, errors.Is(err, testcase.err))
+
+				return
+			}
+
+			is.Empty(t, err)
+			is.Equal(t, testcase.wants, s)
+		})
+	}
+}
+
+func TestStepSchedule(t *testing.T) {
+	for _, testcase := range []struct {
+		name     string
+		schedule StepSchedule
+		minimum  int
+	}{
+		{
+			name:     "Empty",
+			schedule: StepSchedule{Max: 59},
+		},
+		{
+			name:     "SingleStep",
+			schedule: StepSchedule{Max: 59, Steps: []int{30}},
+		},
+		{
+			name:     "EveryFifteenMinutes",
+			schedule: NewStepSchedule(0, 59, 59, 15),
+		},
+		{
+			name:     "EverySecond",
+			schedule: NewStepSchedule(0, 59, 59, 1),
+		},
+		{
+			name:     "IrregularHours",
+			schedule: StepSchedule{Max: 23, Steps: []int{1, 2, 9, 17, 23}},
+		},
+		{
+			name:     "MonthDays",
+			schedule: StepSchedule{Max: 31, Steps: []int{1, 15, 31}},
+			minimum:  1,
+		},
+		{
+			name:     "Weekdays",
+			schedule: StepSchedule{Max: 7, Steps: []int{0, 3, 6}},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			for value := testcase.minimum; value <= testcase.schedule.Max; value++ {
+				is.Equal(t, linearResolve(testcase.schedule, value), testcase.schedule.Resolve(value))
+				is.Equal(t, linearResolvePrev(testcase.schedule, value), testcase.schedule.ResolvePrev(value))
+			}
+		})
+	}
+}
+
+func BenchmarkStepSchedule_Resolve(b *testing.B) {
+	s := NewStepSchedule(0, 59, 59, 1)
+
+	b.Run("Linear", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = linearResolve(s, i%60)
+		}
+	})
+
+	b.Run("BinarySearch", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = s.Resolve(i % 60)
+		}
+	})
+}
+
+func TestContains(t *testing.T) {
+	for _, testcase := range []struct {
+		name     string
+		resolver interface {
+			Resolve(int) int
+			Contains(int) bool
+		}
+		maximum int
+		wants   []int
+	}{
+		{
+			name:     "Everytime",
+			resolver: Everytime{},
+			maximum:  5,
+			wants:    []int{0, 1, 2, 3, 4, 5},
+		},
+		{
+			name:     "FixedSchedule",
+			resolver: FixedSchedule{Max: 59, At: 30},
+			maximum:  59,
+			wants:    []int{30},
+		},
+		{
+			name:     "RangeSchedule",
+			resolver: RangeSchedule{Max: 23, From: 9, To: 12},
+			maximum:  23,
+			wants:    []int{9, 10, 11, 12},
+		},
+		{
+			name:     "WrappingRangeSchedule",
+			resolver: RangeSchedule{Max: 23, From: 22, To: 1},
+			maximum:  23,
+			wants:    []int{0, 1, 22, 23},
+		},
+		{
+			name:     "StepSchedule",
+			resolver: NewStepSchedule(0, 59, 59, 20),
+			maximum:  59,
+			wants:    []int{0, 20, 40},
+		},
+		{
+			name:     "EmptyStepSchedule",
+			resolver: StepSchedule{Max: 59},
+			maximum:  59,
+			wants:    []int{},
+		},
+		{
+			name:     "FromEndSchedule",
+			resolver: FromEndSchedule{Max: 31, FromEnd: 2},
+			maximum:  31,
+			wants:    []int{30},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			got := make([]int, 0, len(testcase.wants))
+
+			for value := 0; value <= testcase.maximum; value++ {
+				if testcase.resolver.Contains(value) {
+					got = append(got, value)
+				}
+
+				// a contained value is one with no distance to the next occurrence
+				is.Equal(t, testcase.resolver.Resolve(value) == 0, testcase.resolver.Contains(value))
+			}
+
+			is.EqualElements(t, testcase.wants, got)
+		})
+	}
+
+	t.Run("YearSchedule", func(t *testing.T) {
+		s := YearSchedule{Years: []int{2025, 2027}}
+
+		is.True(t, s.Contains(2025))
+		is.False(t, s.Contains(2026))
+		is.True(t, s.Contains(2027))
+		is.False(t, s.Contains(2028))
+	})
+}
+
+func TestEqual(t *testing.T) {
+	t.Run("StepSchedule", func(t *testing.T) {
+		is.True(t, StepSchedule{Max: 59, Steps: []int{30, 0, 30}}.Equal(StepSchedule{Max: 59, Steps: []int{0, 30}}))
+		is.True(t, StepSchedule{Max: 59}.Equal(StepSchedule{Max: 59, Steps: []int{}}))
+		is.True(t, !StepSchedule{Max: 59, Steps: []int{0}}.Equal(StepSchedule{Max: 23, Steps: []int{0}}))
+		is.True(t, !StepSchedule{Max: 59, Steps: []int{0}}.Equal(StepSchedule{Max: 59, Steps: []int{0, 30}}))
+	})
+
+	t.Run("YearSchedule", func(t *testing.T) {
+		is.True(t, YearSchedule{Years: []int{2030, 2025}}.Equal(YearSchedule{Years: []int{2025, 2030, 2030}}))
+		is.True(t, !YearSchedule{Years: []int{2025}}.Equal(YearSchedule{Years: []int{2030}}))
+	})
+
+	t.Run("FixedAndRange", func(t *testing.T) {
+		is.True(t, FixedSchedule{Max: 59, At: 5}.Equal(FixedSchedule{Max: 59, At: 5}))
+		is.True(t, !FixedSchedule{Max: 59, At: 5}.Equal(FixedSchedule{Max: 59, At: 6}))
+		is.True(t, RangeSchedule{Max: 23, From: 22, To: 2}.Equal(RangeSchedule{Max: 23, From: 22, To: 2}))
+		is.True(t, !RangeSchedule{Max: 23, From: 22, To: 2}.Equal(RangeSchedule{Max: 23, From: 2, To: 22}))
+	})
+}
+
+func TestExpand(t *testing.T) {
+	is.EqualElements(t, []int{5}, FixedSchedule{Max: 59, At: 5}.Expand())
+	is.EqualElements(t, []int{1, 2, 3}, RangeSchedule{Max: 6, From: 1, To: 3}.Expand())
+	is.EqualElements(t, []int{0, 1, 2, 22, 23}, RangeSchedule{Max: 23, From: 22, To: 2}.Expand())
+	is.EqualElements(t, []int{0, 15, 30, 45}, StepSchedule{Max: 59, Steps: []int{30, 0, 45, 15, 30}}.Expand())
+	is.Equal(t, 0, len(StepSchedule{Max: 59}.Expand()))
+	is.EqualElements(t, []int{31}, FromEndSchedule{Max: 31, FromEnd: 1}.Expand())
+	is.EqualElements(t, []int{2025, 2030}, YearSchedule{Years: []int{2030, 2025}}.Expand())
+}
+
+func TestFromEndSchedule(t *testing.T) {
+	for _, testcase := range []struct {
+		name        string
+		fromEnd     int
+		maximum     int
+		daysInMonth int
+		wants       []int
+		err         error
+	}{
+		{
+			name:        "LastDay/31Days",
+			fromEnd:     1,
+			maximum:     31,
+			daysInMonth: 31,
+			wants:       []int{31},
+		},
+		{
+			name:        "LastDay/LeapFebruary",
+			fromEnd:     1,
+			maximum:     31,
+			daysInMonth: 29,
+			wants:       []int{29},
+		},
+		{
+			name:        "SecondToLastDay/30Days",
+			fromEnd:     2,
+			maximum:     31,
+			daysInMonth: 30,
+			wants:       []int{29},
+		},
+		{
+			name:        "ThirtyFirstToLastDay/ShorterMonth",
+			fromEnd:     31,
+			maximum:     31,
+			daysInMonth: 28,
+			wants:       []int{},
+		},
+		{
+			name:    "Fail/Zero",
+			fromEnd: 0,
+			maximum: 31,
+			err:     ErrOutOfBounds,
+		},
+		{
+			name:    "Fail/AboveMaximum",
+			fromEnd: 32,
+			maximum: 31,
+			err:     ErrOutOfBounds,
+		},
+		{
+			name:    "Fail/InvalidMaximum",
+			fromEnd: 1,
+			err:     ErrInvalidMaximum,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			s, err := NewFromEndSchedule(testcase.fromEnd, testcase.maximum)
+			if testcase.err != nil {
+				is.True(t, errors.Is(err, testcase.err))
+
+				return
+			}
+
+			is.Empty(t, err)
+
+			got := make([]int, 0, len(testcase.wants))
+
+			for day := 1; day <= testcase.daysInMonth; day++ {
+				if s.ContainsDay(day, testcase.daysInMonth) {
+					got = append(got, day)
+				}
+			}
+
+			is.EqualElements(t, testcase.wants, got)
+		})
+	}
+}
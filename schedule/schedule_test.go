@@ -1,10 +1,12 @@
 package schedule
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"io"
 	"log/slog"
+	"strings"
 	"testing"
 	"time"
 
@@ -14,6 +16,7 @@ import (
 
 	"github.com/zalgonoise/micron/log"
 	"github.com/zalgonoise/micron/metrics"
+	"github.com/zalgonoise/micron/schedule/builder"
 	"github.com/zalgonoise/micron/schedule/cronlex"
 	"github.com/zalgonoise/micron/schedule/resolve"
 )
@@ -113,33 +116,1188 @@ func TestCronSchedule_Next(t *testing.T) {
 			input: time.Date(2023, 10, 30, 10, 12, 43, 0, time.UTC),
 			wants: time.Date(2023, 10, 30, 11, 0, 0, 0, time.UTC),
 		},
+		{
+			name:  "Success/WrapAroundRange/BeforeMidnight",
+			cron:  "0 22-2 * * *",
+			input: time.Date(2023, 10, 30, 23, 12, 43, 0, time.UTC),
+			wants: time.Date(2023, 10, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/WrapAroundRange/AfterMidnight",
+			cron:  "0 22-2 * * *",
+			input: time.Date(2023, 10, 31, 1, 12, 43, 0, time.UTC),
+			wants: time.Date(2023, 10, 31, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/SundayAsZero/EveryMinute",
+			cron:  "* * * * 0",
+			input: time.Date(2023, 11, 4, 23, 59, 43, 0, time.UTC),
+			wants: time.Date(2023, 11, 5, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/SundayAsSeven/EveryMinute",
+			cron:  "* * * * 7",
+			input: time.Date(2023, 11, 4, 23, 59, 43, 0, time.UTC),
+			wants: time.Date(2023, 11, 5, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/SundayAsZero/OnSunday",
+			cron:  "* * * * 0",
+			input: time.Date(2023, 11, 5, 10, 12, 43, 0, time.UTC),
+			wants: time.Date(2023, 11, 5, 10, 13, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/SundayAsSeven/OnSunday",
+			cron:  "* * * * 7",
+			input: time.Date(2023, 11, 5, 10, 12, 43, 0, time.UTC),
+			wants: time.Date(2023, 11, 5, 10, 13, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/SundayAsZero/Midnight",
+			cron:  "0 0 * * 0",
+			input: time.Date(2023, 10, 30, 10, 12, 43, 0, time.UTC),
+			wants: time.Date(2023, 11, 5, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/SundayAsSeven/Midnight",
+			cron:  "0 0 * * 7",
+			input: time.Date(2023, 10, 30, 10, 12, 43, 0, time.UTC),
+			wants: time.Date(2023, 11, 5, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/SundayAsSeven/RangeEndingOnSunday",
+			cron:  "* * * * 5-7",
+			input: time.Date(2023, 11, 4, 23, 59, 43, 0, time.UTC),
+			wants: time.Date(2023, 11, 5, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/FixedHourLaterToday",
+			cron:  "0 9 * * *",
+			input: time.Date(2023, 10, 30, 6, 12, 43, 0, time.UTC),
+			wants: time.Date(2023, 10, 30, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/FirstSecondOfTheYear",
+			cron:  "0 0 0 1 1 *",
+			input: time.Date(2023, 12, 31, 23, 59, 59, 0, time.UTC),
+			wants: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/DayOfMonthOrWeek/WeekdayFirst",
+			cron:  "0 0 13 * 5",
+			input: time.Date(2023, 10, 30, 10, 12, 43, 0, time.UTC),
+			wants: time.Date(2023, 11, 3, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/DayOfMonthOrWeek/DayOfMonthFirst",
+			cron:  "0 0 13 * 5",
+			input: time.Date(2023, 11, 10, 12, 0, 0, 0, time.UTC),
+			wants: time.Date(2023, 11, 13, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/DayOfMonthOnly",
+			cron:  "0 0 13 * *",
+			input: time.Date(2023, 10, 30, 10, 12, 43, 0, time.UTC),
+			wants: time.Date(2023, 11, 13, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/DayOfWeekOnly",
+			cron:  "0 0 * * 5",
+			input: time.Date(2023, 11, 4, 10, 12, 43, 0, time.UTC),
+			wants: time.Date(2023, 11, 10, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/EveryInterval",
+			cron:  "@every 90s",
+			input: time.Date(2023, 10, 30, 10, 12, 43, 0, time.UTC),
+			wants: time.Date(2023, 10, 30, 10, 14, 13, 0, time.UTC),
+		},
+		{
+			name:  "Success/WithYear/FutureYear",
+			cron:  "0 0 0 * * * 2025",
+			input: time.Date(2023, 10, 30, 10, 12, 43, 0, time.UTC),
+			wants: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/WithYear/CurrentYear",
+			cron:  "0 0 0 * * * 2023",
+			input: time.Date(2023, 10, 30, 10, 12, 43, 0, time.UTC),
+			wants: time.Date(2023, 10, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/WithYear/PastYear",
+			cron:  "0 0 0 1 1 * 2020",
+			input: time.Date(2023, 10, 30, 10, 12, 43, 0, time.UTC),
+			wants: time.Time{},
+		},
+		{
+			name:  "Success/WithYear/LastOccurrencePassed",
+			cron:  "0 0 0 1 1 * 2023",
+			input: time.Date(2023, 10, 30, 10, 12, 43, 0, time.UTC),
+			wants: time.Time{},
+		},
+		{
+			name:  "Success/WithYear/FarFutureYear",
+			cron:  "0 0 0 1 1 * 2037",
+			input: time.Date(2026, 10, 15, 10, 12, 43, 0, time.UTC),
+			wants: time.Date(2037, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/WithYear/FarFutureYear/2040",
+			cron:  "0 0 0 1 1 * 2040",
+			input: time.Date(2026, 10, 15, 10, 12, 43, 0, time.UTC),
+			wants: time.Date(2040, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/WithYear/LastValidYear",
+			cron:  "0 0 0 1 1 * 2099",
+			input: time.Date(2026, 10, 15, 10, 12, 43, 0, time.UTC),
+			wants: time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/WithYear/DistantLeapDay",
+			cron:  "0 0 0 29 2 * 2096",
+			input: time.Date(2026, 10, 15, 10, 12, 43, 0, time.UTC),
+			wants: time.Date(2096, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/WithYear/DistantNonLeapYear",
+			cron:  "0 0 0 29 2 * 2097-2099",
+			input: time.Date(2026, 10, 15, 10, 12, 43, 0, time.UTC),
+			wants: time.Time{},
+		},
+		{
+			name:  "Success/Impossible/FebruaryThirtieth",
+			cron:  "0 0 30 2 *",
+			input: time.Date(2023, 10, 30, 10, 12, 43, 0, time.UTC),
+			wants: time.Time{},
+		},
+		{
+			name:  "Success/Impossible/AprilThirtyFirst",
+			cron:  "0 0 31 4 *",
+			input: time.Date(2023, 10, 30, 10, 12, 43, 0, time.UTC),
+			wants: time.Time{},
+		},
+		{
+			name:  "Success/Impossible/OnlyInPastLeapDay",
+			cron:  "0 0 0 29 2 * 2023",
+			input: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			wants: time.Time{},
+		},
+		{
+			name:  "Success/LeapDay",
+			cron:  "0 0 29 2 *",
+			input: time.Date(2023, 10, 30, 10, 12, 43, 0, time.UTC),
+			wants: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/LeapDayAcrossCenturies",
+			cron:  "0 0 29 2 *",
+			input: time.Date(2096, 3, 1, 0, 0, 0, 0, time.UTC),
+			wants: time.Date(2104, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/ImpossibleDayOfMonthOrWeekday",
+			cron:  "0 0 30 2 1",
+			input: time.Date(2023, 10, 30, 10, 12, 43, 0, time.UTC),
+			wants: time.Date(2024, 2, 5, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/FromEnd/LastDayOf31DayMonth",
+			cron:  "0 0 -1 * *",
+			input: time.Date(2023, 10, 30, 10, 12, 43, 0, time.UTC),
+			wants: time.Date(2023, 10, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/FromEnd/LastDayOf30DayMonth",
+			cron:  "0 0 -1 * *",
+			input: time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC),
+			wants: time.Date(2023, 11, 30, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/FromEnd/LastDayOfFebruary",
+			cron:  "0 0 -1 * *",
+			input: time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC),
+			wants: time.Date(2023, 2, 28, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/FromEnd/LastDayOfLeapFebruary",
+			cron:  "0 0 -1 * *",
+			input: time.Date(2024, 2, 28, 0, 0, 0, 0, time.UTC),
+			wants: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/FromEnd/SecondToLastDayNextMonth",
+			cron:  "0 0 -2 * *",
+			input: time.Date(2023, 10, 30, 10, 12, 43, 0, time.UTC),
+			wants: time.Date(2023, 11, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/FromEnd/SkipsShorterMonths",
+			cron:  "0 0 -31 * *",
+			input: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			wants: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/FromEnd/OnlyInFebruary",
+			cron:  "0 0 -1 2 *",
+			input: time.Date(2023, 10, 30, 10, 12, 43, 0, time.UTC),
+			wants: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
 		{
 			name: "Success/InvalidCronString",
 			cron: "*",
 
-			input: time.Date(2023, 10, 30, 10, 12, 43, 0, time.UTC),
-			err:   cronlex.ErrInvalidNodeType,
+			input: time.Date(2023, 10, 30, 10, 12, 43, 0, time.UTC),
+			err:   cronlex.ErrInvalidNodeType,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			sched, err := New(
+				WithSchedule(testcase.cron),
+				WithLocation(time.UTC),
+				WithLogHandler(log.NoOp()),
+				WithMetrics(metrics.NoOp()),
+				WithTrace(noop.NewTracerProvider().Tracer("test")),
+			)
+			if testcase.err != nil {
+				is.True(t, errors.Is(err, testcase.err))
+
+				return
+			}
+
+			is.Empty(t, err)
+
+			next := sched.Next(context.Background(), testcase.input)
+
+			is.Equal(t, testcase.wants, next)
+		})
+	}
+}
+
+func TestCronSchedule_NextWithLocation(t *testing.T) {
+	for _, testcase := range []struct {
+		name  string
+		cron  string
+		loc   string
+		input time.Time
+		wants time.Time
+		err   error
+	}{
+		{
+			name:  "Success/CronTZ",
+			cron:  "CRON_TZ=Asia/Kolkata 0 * * * *",
+			loc:   "Asia/Kolkata",
+			input: time.Date(2023, 10, 30, 10, 12, 43, 0, time.UTC),
+			wants: time.Date(2023, 10, 30, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/TZ",
+			cron:  "TZ=America/New_York 0 * * * *",
+			loc:   "America/New_York",
+			input: time.Date(2023, 10, 30, 10, 12, 43, 0, time.UTC),
+			wants: time.Date(2023, 10, 30, 11, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Fail/UnknownZone",
+			cron: "CRON_TZ=Nowhere/Atlantis 0 9 * * *",
+			err:  cronlex.ErrInvalidLocation,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			sched, err := New(
+				WithSchedule(testcase.cron),
+				WithLocation(time.UTC),
+			)
+			if testcase.err != nil {
+				is.True(t, errors.Is(err, testcase.err))
+
+				return
+			}
+
+			is.Empty(t, err)
+
+			next := sched.Next(context.Background(), testcase.input)
+
+			is.True(t, testcase.wants.Equal(next))
+			is.Equal(t, testcase.loc, next.Location().String())
+		})
+	}
+}
+
+func TestCronSchedule_NextWithDST(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	is.Empty(t, err)
+
+	for _, testcase := range []struct {
+		name  string
+		cron  string
+		input time.Time
+		wants time.Time
+	}{
+		{
+			name:  "SpringForward/InGap",
+			cron:  "0 30 2 * * *",
+			input: time.Date(2024, 3, 10, 1, 0, 0, 0, loc),
+			wants: time.Date(2024, 3, 10, 7, 0, 0, 0, time.UTC), // 3:00 AM EDT
+		},
+		{
+			name:  "SpringForward/AfterGapOccurrence",
+			cron:  "0 30 2 * * *",
+			input: time.Date(2024, 3, 10, 7, 0, 0, 0, time.UTC),
+			wants: time.Date(2024, 3, 11, 6, 30, 0, 0, time.UTC), // 2:30 AM EDT
+		},
+		{
+			name:  "SpringForward/AfterGap",
+			cron:  "0 30 3 * * *",
+			input: time.Date(2024, 3, 10, 1, 0, 0, 0, loc),
+			wants: time.Date(2024, 3, 10, 7, 30, 0, 0, time.UTC), // 3:30 AM EDT
+		},
+		{
+			name:  "SpringForward/Hourly",
+			cron:  "0 0 * * * *",
+			input: time.Date(2024, 3, 10, 1, 30, 0, 0, loc),
+			wants: time.Date(2024, 3, 10, 7, 0, 0, 0, time.UTC), // 3:00 AM EDT
+		},
+		{
+			name:  "FallBack/FirstOccurrence",
+			cron:  "0 30 1 * * *",
+			input: time.Date(2024, 11, 3, 0, 0, 0, 0, loc),
+			wants: time.Date(2024, 11, 3, 5, 30, 0, 0, time.UTC), // 1:30 AM EDT
+		},
+		{
+			name:  "FallBack/RepeatedOccurrenceSkipped",
+			cron:  "0 30 1 * * *",
+			input: time.Date(2024, 11, 3, 5, 30, 0, 0, time.UTC),
+			wants: time.Date(2024, 11, 4, 6, 30, 0, 0, time.UTC), // 1:30 AM EST, on the following day
+		},
+		{
+			name:  "FallBack/HourlyRunsOnRepeatedHour",
+			cron:  "0 0 * * * *",
+			input: time.Date(2024, 11, 3, 5, 0, 0, 0, time.UTC), // 1:00 AM EDT
+			wants: time.Date(2024, 11, 3, 6, 0, 0, 0, time.UTC), // 1:00 AM EST
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			sched, err := New(
+				WithSchedule(testcase.cron),
+				WithLocation(loc),
+				WithLogHandler(log.NoOp()),
+				WithMetrics(metrics.NoOp()),
+				WithTrace(noop.NewTracerProvider().Tracer("test")),
+			)
+			is.Empty(t, err)
+
+			next := sched.Next(context.Background(), testcase.input)
+			is.True(t, testcase.wants.Equal(next))
+		})
+	}
+}
+
+func TestCronSchedule_Prev(t *testing.T) {
+	for _, testcase := range []struct {
+		name  string
+		cron  string
+		input time.Time
+		wants time.Time
+	}{
+		{
+			name:  "Success/EverySecond",
+			cron:  "* * * * * *",
+			input: time.Date(2023, 10, 30, 10, 12, 43, 500, time.UTC),
+			wants: time.Date(2023, 10, 30, 10, 12, 43, 0, time.UTC),
+		},
+		{
+			name:  "Success/OneHour",
+			cron:  "0 * * * *",
+			input: time.Date(2023, 10, 30, 10, 12, 43, 0, time.UTC),
+			wants: time.Date(2023, 10, 30, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/EveryFifteenMinutes",
+			cron:  "*/15 * * * *",
+			input: time.Date(2023, 10, 30, 10, 12, 43, 0, time.UTC),
+			wants: time.Date(2023, 10, 30, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/OnOccurrence",
+			cron:  "0 0 * * *",
+			input: time.Date(2023, 10, 30, 0, 0, 0, 0, time.UTC),
+			wants: time.Date(2023, 10, 30, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/WithWeekday/NoWeekends",
+			cron:  "0 9 * * 1-5",
+			input: time.Date(2023, 11, 5, 10, 0, 0, 0, time.UTC),
+			wants: time.Date(2023, 11, 3, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/DayOfMonthOrWeek",
+			cron:  "0 0 13 * 5",
+			input: time.Date(2023, 11, 12, 10, 0, 0, 0, time.UTC),
+			wants: time.Date(2023, 11, 10, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/FromEnd/LastDayOfPreviousMonth",
+			cron:  "0 0 -1 * *",
+			input: time.Date(2023, 3, 15, 0, 0, 0, 0, time.UTC),
+			wants: time.Date(2023, 2, 28, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/FromEnd/SecondToLastDayOfLeapFebruary",
+			cron:  "0 0 -2 * *",
+			input: time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC),
+			wants: time.Date(2024, 2, 28, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/WrapAroundRange",
+			cron:  "0 22-2 * * *",
+			input: time.Date(2023, 10, 31, 10, 0, 0, 0, time.UTC),
+			wants: time.Date(2023, 10, 31, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/LastSecondOfTheYear",
+			cron:  "30 59 23 31 12 *",
+			input: time.Date(2024, 1, 1, 0, 0, 10, 0, time.UTC),
+			wants: time.Date(2023, 12, 31, 23, 59, 30, 0, time.UTC),
+		},
+		{
+			name:  "Success/WithYear/PastYear",
+			cron:  "0 0 0 1 1 * 2025",
+			input: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+			wants: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/WithYear/FutureYear",
+			cron:  "0 0 0 1 1 * 2025",
+			input: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+			wants: time.Time{},
+		},
+		{
+			name:  "Success/WithYear/DistantPastYear",
+			cron:  "0 0 0 1 1 * 2030",
+			input: time.Date(2099, 6, 1, 0, 0, 0, 0, time.UTC),
+			wants: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/WithYear/DistantPastLeapDay",
+			cron:  "0 0 0 29 2 * 2028",
+			input: time.Date(2099, 6, 1, 0, 0, 0, 0, time.UTC),
+			wants: time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			sched, err := New(
+				WithSchedule(testcase.cron),
+				WithLocation(time.UTC),
+				WithLogHandler(log.NoOp()),
+				WithMetrics(metrics.NoOp()),
+				WithTrace(noop.NewTracerProvider().Tracer("test")),
+			)
+			is.Empty(t, err)
+
+			is.Equal(t, testcase.wants, sched.Prev(context.Background(), testcase.input))
+		})
+	}
+}
+
+func TestCronSchedule_NextN(t *testing.T) {
+	input := time.Date(2023, 10, 30, 10, 12, 43, 0, time.UTC)
+
+	for _, testcase := range []struct {
+		name  string
+		cron  string
+		n     int
+		wants []time.Time
+	}{
+		{
+			name: "Success/FiveOccurrences",
+			cron: "0 */6 * * *",
+			n:    5,
+			wants: []time.Time{
+				time.Date(2023, 10, 30, 12, 0, 0, 0, time.UTC),
+				time.Date(2023, 10, 30, 18, 0, 0, 0, time.UTC),
+				time.Date(2023, 10, 31, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, 10, 31, 6, 0, 0, 0, time.UTC),
+				time.Date(2023, 10, 31, 12, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Success/EverySecond",
+			cron: "* * * * * *",
+			n:    3,
+			wants: []time.Time{
+				time.Date(2023, 10, 30, 10, 12, 44, 0, time.UTC),
+				time.Date(2023, 10, 30, 10, 12, 45, 0, time.UTC),
+				time.Date(2023, 10, 30, 10, 12, 46, 0, time.UTC),
+			},
+		},
+		{
+			name: "Success/FewerOccurrencesThanRequested",
+			cron: "0 0 0 1 1 * 2024-2025",
+			n:    5,
+			wants: []time.Time{
+				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "Success/Zero",
+			cron:  "* * * * *",
+			n:     0,
+			wants: []time.Time{},
+		},
+		{
+			name:  "Success/Negative",
+			cron:  "* * * * *",
+			n:     -3,
+			wants: []time.Time{},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			sched, err := New(
+				WithSchedule(testcase.cron),
+				WithLocation(time.UTC),
+				WithLogHandler(log.NoOp()),
+				WithMetrics(metrics.NoOp()),
+				WithTrace(noop.NewTracerProvider().Tracer("test")),
+			)
+			is.Empty(t, err)
+
+			cron, ok := sched.(*CronSchedule)
+			is.True(t, ok)
+			times := cron.NextN(context.Background(), input, testcase.n)
+			is.Equal(t, len(testcase.wants), len(times))
+
+			for i := range testcase.wants {
+				is.Equal(t, testcase.wants[i], times[i])
+			}
+		})
+	}
+}
+
+func TestCronSchedule_Upcoming(t *testing.T) {
+	input := time.Date(2023, 10, 30, 10, 12, 43, 0, time.UTC)
+
+	newSchedule := func(t *testing.T, cron string) *CronSchedule {
+		sched, err := New(
+			WithSchedule(cron),
+			WithLocation(time.UTC),
+			WithLogHandler(log.NoOp()),
+			WithMetrics(metrics.NoOp()),
+			WithTrace(noop.NewTracerProvider().Tracer("test")),
+		)
+		is.Empty(t, err)
+
+		cronSched, ok := sched.(*CronSchedule)
+		is.True(t, ok)
+
+		return cronSched
+	}
+
+	t.Run("BreakEarly", func(t *testing.T) {
+		wants := []time.Time{
+			time.Date(2023, 10, 30, 11, 0, 0, 0, time.UTC),
+			time.Date(2023, 10, 30, 12, 0, 0, 0, time.UTC),
+			time.Date(2023, 10, 30, 13, 0, 0, 0, time.UTC),
+		}
+
+		times := make([]time.Time, 0, len(wants))
+
+		newSchedule(t, "0 * * * *").Upcoming(context.Background(), input)(func(next time.Time) bool {
+			times = append(times, next)
+
+			return len(times) < len(wants)
+		})
+
+		is.Equal(t, len(wants), len(times))
+
+		for i := range wants {
+			is.Equal(t, wants[i], times[i])
+		}
+	})
+
+	t.Run("NoFurtherOccurrences", func(t *testing.T) {
+		var count int
+
+		newSchedule(t, "0 0 0 1 1 * 2024-2025").Upcoming(context.Background(), input)(func(time.Time) bool {
+			count++
+
+			return true
+		})
+
+		is.Equal(t, 2, count)
+	})
+
+	t.Run("ContextCancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		var count int
+
+		newSchedule(t, "* * * * * *").Upcoming(ctx, input)(func(time.Time) bool {
+			count++
+
+			if count == 2 {
+				cancel()
+			}
+
+			return true
+		})
+
+		is.Equal(t, 2, count)
+	})
+}
+
+func TestOnceSchedule_Next(t *testing.T) {
+	sched, err := New(
+		WithSchedule("@reboot"),
+		WithLocation(time.UTC),
+		WithLogHandler(log.NoOp()),
+		WithMetrics(metrics.NoOp()),
+		WithTrace(noop.NewTracerProvider().Tracer("test")),
+	)
+	is.Empty(t, err)
+
+	start := time.Date(2023, 10, 30, 10, 12, 43, 500, time.UTC)
+	wants := time.Date(2023, 10, 30, 10, 12, 44, 0, time.UTC)
+
+	for _, testcase := range []struct {
+		name    string
+		consume time.Time
+		input   time.Time
+		wants   time.Time
+	}{
+		{
+			name:  "FirstCall",
+			input: start,
+			wants: wants,
+		},
+		{
+			name:  "BeforeOccurrence",
+			input: start.Add(100 * time.Millisecond),
+			wants: wants,
+		},
+		{
+			name:  "OnOccurrence",
+			input: wants,
+			wants: wants,
+		},
+		{
+			// the occurrence is still due, as it did not run yet
+			name:  "AfterOccurrence",
+			input: wants.Add(time.Hour),
+			wants: wants,
+		},
+		{
+			name:    "ConsumedOtherTime",
+			consume: start,
+			input:   wants.Add(time.Hour),
+			wants:   wants,
+		},
+		{
+			name:    "Consumed",
+			consume: wants,
+			input:   start,
+			wants:   time.Time{},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			if !testcase.consume.IsZero() {
+				sched.(Consumer).Consume(testcase.consume)
+			}
+
+			is.Equal(t, testcase.wants, sched.Next(context.Background(), testcase.input))
+		})
+	}
+}
+
+func TestIntervalSchedule_Next(t *testing.T) {
+	sched, err := New(
+		WithSchedule("@every 5s"),
+		WithLocation(time.UTC),
+		WithLogHandler(log.NoOp()),
+		WithMetrics(metrics.NoOp()),
+		WithTrace(noop.NewTracerProvider().Tracer("test")),
+	)
+	is.Empty(t, err)
+
+	start := time.Date(2023, 10, 30, 10, 12, 43, 0, time.UTC)
+
+	// the test cases run in sequence, on the same IntervalSchedule
+	for _, testcase := range []struct {
+		name  string
+		input time.Time
+		wants time.Time
+	}{
+		{
+			name:  "FirstCall",
+			input: start,
+			wants: start.Add(5 * time.Second),
+		},
+		{
+			name:  "BeforeOccurrence",
+			input: start.Add(time.Second),
+			wants: start.Add(5 * time.Second),
+		},
+		{
+			name:  "RightBeforeOccurrence",
+			input: start.Add(4*time.Second + 900*time.Millisecond),
+			wants: start.Add(5 * time.Second),
+		},
+		{
+			name:  "OnOccurrence",
+			input: start.Add(5 * time.Second),
+			wants: start.Add(10 * time.Second),
+		},
+		{
+			name:  "AfterOccurrence/AnchoredOnPrevious",
+			input: start.Add(6*time.Second + 50*time.Millisecond),
+			wants: start.Add(10 * time.Second),
+		},
+		{
+			name:  "LateCall/AnchoredOnPrevious",
+			input: start.Add(10*time.Second + 300*time.Millisecond),
+			wants: start.Add(15 * time.Second),
+		},
+		{
+			name:  "AfterGap",
+			input: start.Add(time.Hour),
+			wants: start.Add(time.Hour + 5*time.Second),
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			is.Equal(t, testcase.wants, sched.Next(context.Background(), testcase.input))
+		})
+	}
+}
+
+func TestIntervalSchedule_Prev(t *testing.T) {
+	sched, err := New(WithSchedule("@every 2s"), WithLocation(time.UTC))
+	is.Empty(t, err)
+
+	start := time.Date(2023, 10, 30, 10, 12, 43, 0, time.UTC)
+
+	// without a pending occurrence, the input time is rewound by the interval
+	is.Equal(t, start.Add(-2*time.Second), sched.Prev(context.Background(), start))
+
+	anchor := sched.Next(context.Background(), start)
+	is.Equal(t, start.Add(2*time.Second), anchor)
+
+	for _, testcase := range []struct {
+		name  string
+		input time.Time
+		wants time.Time
+	}{
+		{
+			name:  "OnAnchor",
+			input: anchor,
+			wants: anchor,
+		},
+		{
+			name:  "AfterAnchor",
+			input: anchor.Add(1100 * time.Millisecond),
+			wants: anchor,
+		},
+		{
+			name:  "SeveralIntervalsAfterAnchor",
+			input: anchor.Add(5100 * time.Millisecond),
+			wants: anchor.Add(4 * time.Second),
+		},
+		{
+			name:  "BeforeAnchor",
+			input: anchor.Add(-100 * time.Millisecond),
+			wants: anchor.Add(-2 * time.Second),
+		},
+		{
+			name:  "IntervalsBeforeAnchor",
+			input: anchor.Add(-4 * time.Second),
+			wants: anchor.Add(-4 * time.Second),
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			is.Equal(t, testcase.wants, sched.Prev(context.Background(), testcase.input))
+		})
+	}
+}
+
+type fixedClock struct {
+	now time.Time
+}
+
+func (c fixedClock) Now() time.Time { return c.now }
+
+func TestWithClock(t *testing.T) {
+	now := time.Date(2023, 10, 30, 10, 12, 43, 0, time.UTC)
+
+	for _, testcase := range []struct {
+		name  string
+		cron  string
+		input time.Time
+		next  time.Time
+		prev  time.Time
+	}{
+		{
+			name: "Cron/ZeroTimeUsesClock",
+			cron: "0 * * * *",
+			next: time.Date(2023, 10, 30, 11, 0, 0, 0, time.UTC),
+			prev: time.Date(2023, 10, 30, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Cron/InputTimeTakesPrecedence",
+			cron:  "0 * * * *",
+			input: now.Add(24 * time.Hour),
+			next:  time.Date(2023, 10, 31, 11, 0, 0, 0, time.UTC),
+			prev:  time.Date(2023, 10, 31, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Interval/ZeroTimeUsesClock",
+			cron: "@every 1m",
+			next: now.Add(time.Minute),
+			// on the grid anchored by the previous Next call
+			prev: now,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			sched, err := New(
+				WithSchedule(testcase.cron),
+				WithLocation(time.UTC),
+				WithClock(fixedClock{now: now}),
+			)
+			is.Empty(t, err)
+
+			is.Equal(t, testcase.next, sched.Next(context.Background(), testcase.input))
+			is.Equal(t, testcase.prev, sched.Prev(context.Background(), testcase.input))
+		})
+	}
+
+	t.Run("Once/ZeroTimeUsesClock", func(t *testing.T) {
+		sched, err := New(
+			WithSchedule("@reboot"),
+			WithLocation(time.UTC),
+			WithClock(fixedClock{now: now}),
+		)
+		is.Empty(t, err)
+
+		is.Equal(t, now.Add(time.Second), sched.Next(context.Background(), time.Time{}))
+	})
+
+	t.Run("Cron/NextN/ZeroTimeUsesClock", func(t *testing.T) {
+		sched, err := New(
+			WithSchedule("0 * * * *"),
+			WithLocation(time.UTC),
+			WithClock(fixedClock{now: now}),
+		)
+		is.Empty(t, err)
+
+		times := sched.(*CronSchedule).NextN(context.Background(), time.Time{}, 2)
+
+		is.Equal(t, 2, len(times))
+		is.Equal(t, time.Date(2023, 10, 30, 11, 0, 0, 0, time.UTC), times[0])
+		is.Equal(t, time.Date(2023, 10, 30, 12, 0, 0, 0, time.UTC), times[1])
+	})
+
+	t.Run("Cron/Upcoming/ZeroTimeUsesClock", func(t *testing.T) {
+		sched, err := New(
+			WithSchedule("0 * * * *"),
+			WithLocation(time.UTC),
+			WithClock(fixedClock{now: now}),
+		)
+		is.Empty(t, err)
+
+		var first time.Time
+
+		sched.(*CronSchedule).Upcoming(context.Background(), time.Time{})(func(next time.Time) bool {
+			first = next
+
+			return false
+		})
+
+		is.Equal(t, time.Date(2023, 10, 30, 11, 0, 0, 0, time.UTC), first)
+	})
+}
+
+func TestScheduler_Until(t *testing.T) {
+	now := time.Date(2023, 10, 30, 10, 12, 43, 0, time.UTC)
+
+	for _, testcase := range []struct {
+		name  string
+		cron  string
+		input time.Time
+		wants time.Duration
+	}{
+		{
+			name:  "Cron",
+			cron:  "0 * * * *",
+			input: now,
+			wants: 47*time.Minute + 17*time.Second,
+		},
+		{
+			name:  "Cron/ZeroTimeUsesClock",
+			cron:  "*/15 * * * *",
+			wants: 2*time.Minute + 17*time.Second,
+		},
+		{
+			name:  "Cron/NoOccurrences",
+			cron:  "0 0 30 2 *",
+			input: now,
+			wants: Never,
+		},
+		{
+			name:  "Interval",
+			cron:  "@every 90s",
+			input: now.Add(time.Hour),
+			wants: 90 * time.Second,
+		},
+		{
+			name:  "Once",
+			cron:  "@reboot",
+			input: now.Add(500 * time.Millisecond),
+			wants: 500 * time.Millisecond,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			sched, err := New(
+				WithSchedule(testcase.cron),
+				WithLocation(time.UTC),
+				WithClock(fixedClock{now: now}),
+			)
+			is.Empty(t, err)
+
+			is.Equal(t, testcase.wants, sched.Until(context.Background(), testcase.input))
+		})
+	}
+
+	t.Run("Once/AfterOccurrence", func(t *testing.T) {
+		sched, err := New(WithSchedule("@reboot"), WithLocation(time.UTC))
+		is.Empty(t, err)
+
+		is.Equal(t, time.Second, sched.Until(context.Background(), now))
+		is.Equal(t, time.Duration(0), sched.Until(context.Background(), now.Add(time.Second)))
+
+		sched.(Consumer).Consume(now.Add(time.Second))
+		is.Equal(t, Never, sched.Until(context.Background(), now.Add(time.Second)))
+	})
+
+	t.Run("NoOp", func(t *testing.T) {
+		is.Equal(t, Never, NoOp().Until(context.Background(), now))
+	})
+}
+
+func TestWithSecondsDisabled(t *testing.T) {
+	now := time.Date(2023, 10, 30, 10, 12, 43, 0, time.UTC)
+
+	for _, testcase := range []struct {
+		name  string
+		cron  string
+		input time.Time
+		next  time.Time
+		prev  time.Time
+	}{
+		{
+			name:  "EverySecond",
+			cron:  "* * * * * *",
+			input: now,
+			next:  time.Date(2023, 10, 30, 10, 13, 0, 0, time.UTC),
+			prev:  time.Date(2023, 10, 30, 10, 12, 0, 0, time.UTC),
+		},
+		{
+			name:  "FixedSecond",
+			cron:  "30 */5 * * * *",
+			input: now,
+			next:  time.Date(2023, 10, 30, 10, 15, 0, 0, time.UTC),
+			prev:  time.Date(2023, 10, 30, 10, 10, 0, 0, time.UTC),
+		},
+		{
+			name:  "OnMinuteBoundary",
+			cron:  "* * * * * *",
+			input: time.Date(2023, 10, 30, 10, 13, 0, 0, time.UTC),
+			next:  time.Date(2023, 10, 30, 10, 14, 0, 0, time.UTC),
+			prev:  time.Date(2023, 10, 30, 10, 13, 0, 0, time.UTC),
+		},
+		{
+			name:  "Secondly",
+			cron:  "@secondly",
+			input: now,
+			next:  time.Date(2023, 10, 30, 10, 13, 0, 0, time.UTC),
+			prev:  time.Date(2023, 10, 30, 10, 12, 0, 0, time.UTC),
+		},
+		{
+			name:  "FiveFields",
+			cron:  "0 * * * *",
+			input: now,
+			next:  time.Date(2023, 10, 30, 11, 0, 0, 0, time.UTC),
+			prev:  time.Date(2023, 10, 30, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "IntervalUnchanged",
+			cron:  "@every 10s",
+			input: now,
+			next:  now.Add(10 * time.Second),
+			// on the grid anchored by the previous Next call
+			prev: now,
 		},
 	} {
 		t.Run(testcase.name, func(t *testing.T) {
 			sched, err := New(
 				WithSchedule(testcase.cron),
 				WithLocation(time.UTC),
-				WithLogHandler(log.NoOp()),
-				WithMetrics(metrics.NoOp()),
-				WithTrace(noop.NewTracerProvider().Tracer("test")),
+				WithSecondsDisabled(),
 			)
-			if testcase.err != nil {
-				is.True(t, errors.Is(err, testcase.err))
+			is.Empty(t, err)
 
-				return
-			}
+			is.Equal(t, testcase.next, sched.Next(context.Background(), testcase.input))
+			is.Equal(t, testcase.prev, sched.Prev(context.Background(), testcase.input))
+		})
+	}
+}
+
+func TestWithAlignment(t *testing.T) {
+	start := time.Date(2023, 10, 30, 10, 2, 0, 0, time.UTC)
 
+	kolkata, err := time.LoadLocation("Asia/Kolkata")
+	is.Empty(t, err)
+
+	for _, testcase := range []struct {
+		name    string
+		cron    string
+		loc     *time.Location
+		aligned bool
+		input   time.Time
+		next    time.Time
+		prev    time.Time
+	}{
+		{
+			name:    "Every/Aligned",
+			cron:    "@every 5m",
+			loc:     time.UTC,
+			aligned: true,
+			input:   start,
+			next:    time.Date(2023, 10, 30, 10, 5, 0, 0, time.UTC),
+			prev:    time.Date(2023, 10, 30, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Every/FromStart",
+			cron:  "@every 5m",
+			loc:   time.UTC,
+			input: start,
+			next:  time.Date(2023, 10, 30, 10, 7, 0, 0, time.UTC),
+			// on the grid anchored by the previous Next call
+			prev: time.Date(2023, 10, 30, 10, 2, 0, 0, time.UTC),
+		},
+		{
+			name:    "Every/Aligned/OnBoundary",
+			cron:    "@every 5m",
+			loc:     time.UTC,
+			aligned: true,
+			input:   time.Date(2023, 10, 30, 10, 5, 0, 0, time.UTC),
+			next:    time.Date(2023, 10, 30, 10, 10, 0, 0, time.UTC),
+			prev:    time.Date(2023, 10, 30, 10, 5, 0, 0, time.UTC),
+		},
+		{
+			name:    "Every/Aligned/WallClock",
+			cron:    "@every 1h",
+			loc:     kolkata,
+			aligned: true,
+			input:   start,
+			next:    time.Date(2023, 10, 30, 16, 0, 0, 0, kolkata),
+			prev:    time.Date(2023, 10, 30, 15, 0, 0, 0, kolkata),
+		},
+		{
+			name:    "Cron/Aligned",
+			cron:    "*/5 * * * *",
+			loc:     time.UTC,
+			aligned: true,
+			input:   start,
+			next:    time.Date(2023, 10, 30, 10, 5, 0, 0, time.UTC),
+			prev:    time.Date(2023, 10, 30, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Cron/Unchanged",
+			cron:  "*/5 * * * *",
+			loc:   time.UTC,
+			input: start,
+			next:  time.Date(2023, 10, 30, 10, 5, 0, 0, time.UTC),
+			prev:  time.Date(2023, 10, 30, 10, 0, 0, 0, time.UTC),
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			sched, err := New(
+				WithSchedule(testcase.cron),
+				WithLocation(testcase.loc),
+				WithAlignment(testcase.aligned),
+			)
 			is.Empty(t, err)
 
-			next := sched.Next(context.Background(), testcase.input)
+			is.True(t, testcase.next.Equal(sched.Next(context.Background(), testcase.input)))
+			is.True(t, testcase.prev.Equal(sched.Prev(context.Background(), testcase.input)))
+		})
+	}
+}
 
-			is.Equal(t, testcase.wants, next)
+func TestWithWeekStart(t *testing.T) {
+	// a Thursday
+	now := time.Date(2023, 11, 2, 10, 12, 43, 0, time.UTC)
+
+	for _, testcase := range []struct {
+		name  string
+		cron  string
+		start time.Weekday
+		next  time.Time
+		prev  time.Time
+	}{
+		{
+			name:  "Default",
+			cron:  "0 9 * * 1",
+			start: time.Sunday,
+			next:  time.Date(2023, 11, 6, 9, 0, 0, 0, time.UTC),
+			prev:  time.Date(2023, 10, 30, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Monday/FirstDay",
+			cron:  "0 9 * * 0",
+			start: time.Monday,
+			next:  time.Date(2023, 11, 6, 9, 0, 0, 0, time.UTC),
+			prev:  time.Date(2023, 10, 30, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Monday/Weekdays",
+			cron:  "0 9 * * 0-4",
+			start: time.Monday,
+			next:  time.Date(2023, 11, 3, 9, 0, 0, 0, time.UTC),
+			prev:  time.Date(2023, 11, 2, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Monday/Weekend",
+			cron:  "0 9 * * 5,6",
+			start: time.Monday,
+			next:  time.Date(2023, 11, 4, 9, 0, 0, 0, time.UTC),
+			prev:  time.Date(2023, 10, 29, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Monday/NamesUnchanged",
+			cron:  "0 9 * * MON",
+			start: time.Monday,
+			next:  time.Date(2023, 11, 6, 9, 0, 0, 0, time.UTC),
+			prev:  time.Date(2023, 10, 30, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "InvalidIgnored",
+			cron:  "0 9 * * 1",
+			start: time.Weekday(7),
+			next:  time.Date(2023, 11, 6, 9, 0, 0, 0, time.UTC),
+			prev:  time.Date(2023, 10, 30, 9, 0, 0, 0, time.UTC),
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			sched, err := New(
+				WithSchedule(testcase.cron),
+				WithLocation(time.UTC),
+				WithWeekStart(testcase.start),
+			)
+			is.Empty(t, err)
+
+			is.Equal(t, testcase.next, sched.Next(context.Background(), now))
+			is.Equal(t, testcase.prev, sched.Prev(context.Background(), now))
 		})
 	}
 }
@@ -163,6 +1321,7 @@ func TestConfig(t *testing.T) {
 			WithLogHandler(nil),
 			WithMetrics(nil),
 			WithTrace(nil),
+			WithClock(nil),
 		)
 
 		is.True(t, errors.Is(err, cronlex.ErrEmptyInput))
@@ -442,3 +1601,320 @@ func TestNoOp(t *testing.T) {
 
 	is.Equal(t, time.Time{}, noOp.Next(context.Background(), time.Now()))
 }
+
+func TestNewFromBuilder(t *testing.T) {
+	now := time.Date(2023, 10, 30, 10, 12, 43, 0, time.UTC)
+
+	for _, testcase := range []struct {
+		name      string
+		loc       *time.Location
+		resolvers []builder.Resolver
+		wantsLoc  *time.Location
+		next      time.Time
+		err       error
+	}{
+		{
+			name:      "Success",
+			loc:       time.UTC,
+			resolvers: []builder.Resolver{builder.Every(30).Minutes(), builder.Every(9).Hours()},
+			wantsLoc:  time.UTC,
+			next:      time.Date(2023, 10, 31, 9, 30, 0, 0, time.UTC),
+		},
+		{
+			name:      "Success/Steps",
+			loc:       time.UTC,
+			resolvers: []builder.Resolver{builder.Step(15).Minutes()},
+			wantsLoc:  time.UTC,
+			next:      time.Date(2023, 10, 30, 10, 15, 0, 0, time.UTC),
+		},
+		{
+			name:      "Success/DefaultLocation",
+			resolvers: []builder.Resolver{builder.Every(30).Minutes()},
+			wantsLoc:  time.Local,
+		},
+		{
+			name:      "Fail/InvertedRange",
+			loc:       time.UTC,
+			resolvers: []builder.Resolver{builder.Range(10, 2).Hours()},
+			err:       builder.ErrInvertedRange,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			sched, err := NewFromBuilder(testcase.loc, testcase.resolvers...)
+			if testcase.err != nil {
+				is.True(t, errors.Is(err, testcase.err))
+				is.True(t, sched == nil)
+
+				return
+			}
+
+			is.Empty(t, err)
+			is.Equal(t, testcase.wantsLoc, sched.Loc)
+
+			if !testcase.next.IsZero() {
+				is.Equal(t, testcase.next, sched.Next(context.Background(), now))
+			}
+		})
+	}
+}
+
+func TestScheduler_NextLogs(t *testing.T) {
+	now := time.Date(2023, 10, 30, 10, 12, 43, 0, time.UTC)
+
+	loc, err := time.LoadLocation("Europe/Lisbon")
+	is.Empty(t, err)
+
+	for _, testcase := range []struct {
+		name  string
+		sched func(t *testing.T, handler slog.Handler) Scheduler
+		attrs []string
+		none  []string
+	}{
+		{
+			name: "Cron",
+			sched: func(t *testing.T, handler slog.Handler) Scheduler {
+				t.Helper()
+
+				s, err := New(WithSchedule("0 9 * * *"), WithLocation(loc), WithLogHandler(handler))
+				is.Empty(t, err)
+
+				return s
+			},
+			attrs: []string{`"location":"Europe/Lisbon"`, `"schedule":"0 9 * * *"`},
+		},
+		{
+			name: "Cron/EmbeddedLocation",
+			sched: func(t *testing.T, handler slog.Handler) Scheduler {
+				t.Helper()
+
+				s, err := New(WithSchedule("CRON_TZ=Europe/Lisbon 0 9 * * *"), WithLogHandler(handler))
+				is.Empty(t, err)
+
+				return s
+			},
+			attrs: []string{`"location":"Europe/Lisbon"`, `"schedule":"CRON_TZ=Europe/Lisbon 0 9 * * *"`},
+		},
+		{
+			name: "Cron/FromBuilder",
+			sched: func(t *testing.T, handler slog.Handler) Scheduler {
+				t.Helper()
+
+				s, err := NewFromBuilder(loc, builder.Every(30).Minutes())
+				is.Empty(t, err)
+
+				return AddLogs(s, handler)
+			},
+			attrs: []string{`"location":"Europe/Lisbon"`},
+			none:  []string{`"schedule"`},
+		},
+		{
+			name: "Interval",
+			sched: func(t *testing.T, handler slog.Handler) Scheduler {
+				t.Helper()
+
+				s, err := New(WithSchedule("@every 5m"), WithLocation(loc), WithLogHandler(handler))
+				is.Empty(t, err)
+
+				return s
+			},
+			attrs: []string{`"location":"Europe/Lisbon"`},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+
+			s := testcase.sched(t, slog.NewJSONHandler(buf, nil))
+			is.False(t, s.Next(context.Background(), now).IsZero())
+
+			for i := range testcase.attrs {
+				is.True(t, strings.Contains(buf.String(), testcase.attrs[i]))
+			}
+
+			for i := range testcase.none {
+				is.False(t, strings.Contains(buf.String(), testcase.none[i]))
+			}
+		})
+	}
+}
+
+func TestCronSchedule_Expr(t *testing.T) {
+	t.Run("FromCronString", func(t *testing.T) {
+		s, err := New(WithSchedule("CRON_TZ=UTC 0 9 * * mon-fri"))
+		is.Empty(t, err)
+
+		cron, ok := s.(*CronSchedule)
+		is.True(t, ok)
+		is.Equal(t, "CRON_TZ=UTC 0 9 * * mon-fri", cron.Expr)
+	})
+
+	t.Run("FromBuilder", func(t *testing.T) {
+		cron, err := NewFromBuilder(time.UTC, builder.Every(30).Minutes())
+		is.Empty(t, err)
+		is.Equal(t, "", cron.Expr)
+	})
+}
+
+func TestOffset(t *testing.T) {
+	now := time.Date(2023, 10, 30, 10, 3, 0, 0, time.UTC)
+
+	hourly, err := New(WithSchedule("0 * * * *"), WithLocation(time.UTC))
+	is.Empty(t, err)
+
+	for _, testcase := range []struct {
+		name   string
+		sched  Scheduler
+		offset time.Duration
+		next   time.Time
+		prev   time.Time
+		until  time.Duration
+	}{
+		{
+			name:   "Positive/BaseAlreadyDue",
+			sched:  hourly,
+			offset: 5 * time.Minute,
+			next:   time.Date(2023, 10, 30, 10, 5, 0, 0, time.UTC),
+			prev:   time.Date(2023, 10, 30, 9, 5, 0, 0, time.UTC),
+			until:  2 * time.Minute,
+		},
+		{
+			name:   "Negative",
+			sched:  hourly,
+			offset: -5 * time.Minute,
+			next:   time.Date(2023, 10, 30, 10, 55, 0, 0, time.UTC),
+			prev:   time.Date(2023, 10, 30, 9, 55, 0, 0, time.UTC),
+			until:  52 * time.Minute,
+		},
+		{
+			name:  "Zero",
+			sched: hourly,
+			next:  time.Date(2023, 10, 30, 11, 0, 0, 0, time.UTC),
+			prev:  time.Date(2023, 10, 30, 10, 0, 0, 0, time.UTC),
+			until: 57 * time.Minute,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			s := Offset(testcase.sched, testcase.offset)
+
+			is.Equal(t, testcase.next, s.Next(context.Background(), now))
+			is.Equal(t, testcase.prev, s.Prev(context.Background(), now))
+			is.Equal(t, testcase.until, s.Until(context.Background(), now))
+		})
+	}
+
+	t.Run("ZeroTimeUsesClock", func(t *testing.T) {
+		s, err := New(WithSchedule("0 * * * *"), WithLocation(time.UTC), WithClock(fixedClock{now}))
+		is.Empty(t, err)
+
+		is.Equal(t, time.Date(2023, 10, 30, 10, 5, 0, 0, time.UTC),
+			Offset(s, 5*time.Minute).Next(context.Background(), time.Time{}))
+	})
+
+	t.Run("Exhausted", func(t *testing.T) {
+		s, err := New(WithSchedule("0 0 0 1 1 * 2020"), WithLocation(time.UTC))
+		is.Empty(t, err)
+
+		offset := Offset(s, time.Hour)
+		is.True(t, offset.Next(context.Background(), now).IsZero())
+		is.Equal(t, Never, offset.Until(context.Background(), now))
+	})
+
+	t.Run("Consume", func(t *testing.T) {
+		s, err := New(WithSchedule("@reboot"), WithLocation(time.UTC))
+		is.Empty(t, err)
+
+		offset := Offset(s, time.Minute)
+		at := offset.Next(context.Background(), now)
+		is.Equal(t, now.Add(time.Second), at)
+
+		offset.(Consumer).Consume(at)
+		is.True(t, offset.Next(context.Background(), now).IsZero())
+	})
+
+	t.Run("NoOp", func(t *testing.T) {
+		is.Equal(t, NoOp(), Offset(nil, time.Minute))
+		is.Equal(t, NoOp(), Offset(NoOp(), time.Minute))
+	})
+}
+
+func TestNext(t *testing.T) {
+	now := time.Date(2023, 10, 30, 10, 12, 43, 0, time.UTC)
+
+	newYork, err := time.LoadLocation("America/New_York")
+	is.Empty(t, err)
+
+	for _, testcase := range []struct {
+		name  string
+		cron  string
+		loc   *time.Location
+		from  time.Time
+		wants time.Time
+		err   error
+	}{
+		{
+			name:  "Success",
+			cron:  "0 9 * * *",
+			loc:   time.UTC,
+			from:  now,
+			wants: time.Date(2023, 10, 31, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Success/WithLocation",
+			cron:  "0 9 * * *",
+			loc:   newYork,
+			from:  now,
+			wants: time.Date(2023, 10, 30, 9, 0, 0, 0, newYork),
+		},
+		{
+			name:  "Success/EmbeddedLocation",
+			cron:  "CRON_TZ=America/New_York 0 9 * * *",
+			loc:   time.UTC,
+			from:  now,
+			wants: time.Date(2023, 10, 30, 9, 0, 0, 0, newYork),
+		},
+		{
+			name:  "Success/Every",
+			cron:  "@every 5m",
+			loc:   time.UTC,
+			from:  now,
+			wants: now.Add(5 * time.Minute),
+		},
+		{
+			name: "Success/NoFurtherOccurrences",
+			cron: "0 0 0 1 1 * 2020",
+			loc:  time.UTC,
+			from: now,
+		},
+		{
+			name: "Fail/InvalidCron",
+			cron: "* * *",
+			loc:  time.UTC,
+			from: now,
+			err:  cronlex.ErrInvalidNumNodes,
+		},
+		{
+			name: "Fail/Empty",
+			loc:  time.UTC,
+			from: now,
+			err:  cronlex.ErrEmptyInput,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			next, err := Next(testcase.cron, testcase.loc, testcase.from)
+			if testcase.err != nil {
+				is.True(t, errors.Is(err, testcase.err))
+				is.True(t, next.IsZero())
+
+				return
+			}
+
+			is.Empty(t, err)
+			is.True(t, testcase.wants.Equal(next))
+		})
+	}
+
+	t.Run("ZeroTime", func(t *testing.T) {
+		next, err := Next("* * * * * *", nil, time.Time{})
+		is.Empty(t, err)
+		is.True(t, next.After(time.Now().Add(-time.Second)))
+	})
+}
@@ -3,20 +3,35 @@ package schedule
 import (
 	"context"
 	"log/slog"
+	"math"
+	"slices"
 	"time"
 
 	"github.com/zalgonoise/cfg"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
+	"github.com/zalgonoise/micron/schedule/builder"
 	"github.com/zalgonoise/micron/schedule/cronlex"
 	"github.com/zalgonoise/micron/schedule/resolve"
 )
 
-const maxSec = 59
+const (
+	maxSec   = 59
+	maxMin   = 59
+	maxHour  = 23
+	minMonth = 1
+	maxMonth = 12
 
-//nolint:gochecknoglobals // immutable instance of resolve.FixedSchedule for a fixed seconds schedule
-var fixedSeconds = resolve.FixedSchedule{Max: maxSec, At: 0}
+	// searchYears bounds the search for an occurrence, so that schedules that never trigger (e.g. on February 30th)
+	// do not result in an infinite loop. It covers the longest gap between valid dates, as leap days can be 8 years
+	// apart (e.g. from 2096 to 2104). It only applies to schedules that do not constrain the year, as the ones that
+	// do are bounded by their years instead.
+	searchYears = 10
+
+	// maxOccurrences caps the number of occurrences returned by NextN.
+	maxOccurrences = 1000
+)
 
 // Scheduler describes the capabilities of a cron job scheduler. Its sole responsibility is to provide
 // the timestamp for the next job's execution, after calculating its frequency from its configuration.
@@ -25,14 +40,40 @@ var fixedSeconds = resolve.FixedSchedule{Max: maxSec, At: 0}
 // input time is the time.Now value, however it is open to any input that the caller desires to pass to it. The returned
 // time.Time value must always be the following occurrence according to the schedule, in the context of the input time.
 //
+// The Scheduler implementations in this package resolve a zero input time.Time as the current time, from their
+// configured Clock (see WithClock).
+//
+// A zero time.Time value signals that the schedule has no further occurrences (e.g. an `@reboot` schedule that has
+// already been triggered), and that its caller should not expect any other executions from it.
+//
 // Implementations of Next should take into consideration the cron specification; however the interface allows a custom
 // approach to the scheduler, especially if added functionality is necessary (e.g. frequency overriding schedulers,
 // dynamic frequencies, and pipeline-approaches where the frequency is evaluated after a certain check).
 type Scheduler interface {
 	// Next calculates and returns the following scheduled time, from the input time.Time.
 	Next(ctx context.Context, now time.Time) time.Time
+	// Prev calculates and returns the most recent scheduled time, at or before the input time.Time.
+	Prev(ctx context.Context, now time.Time) time.Time
+	// Until returns the duration from the input time.Time to the following scheduled time, as returned by Next.
+	//
+	// A zero or negative duration means that the schedule is due (e.g. its following scheduled time is the input
+	// time.Time). If the schedule has no further occurrences, Never is returned.
+	Until(ctx context.Context, now time.Time) time.Duration
 }
 
+// Consumer describes a Scheduler whose occurrences are only spent once they run, like the OnceSchedule. Its Next method
+// keeps returning a due occurrence until it is consumed.
+//
+// Callers running the Scheduler's occurrences (like the executor.Executable) call Consume with each occurrence that
+// they run, as returned by the Scheduler's Next method.
+type Consumer interface {
+	// Consume marks the input occurrence as spent.
+	Consume(at time.Time)
+}
+
+// Never is the duration returned by a Scheduler's Until method when it has no further occurrences.
+const Never = time.Duration(math.MaxInt64)
+
 // Metrics describes the actions that register Scheduler-related metrics.
 type Metrics interface {
 	// IncSchedulerNextCalls increases the count of Next calls, by the Scheduler.
@@ -47,6 +88,11 @@ type CronSchedule struct {
 	Loc *time.Location
 	// Schedule describes the schedule frequency definition, with different cron schedule elements.
 	Schedule cronlex.Schedule
+	// Expr is the cron string that the Schedule was parsed from, as supplied with the WithSchedule option. It is empty
+	// when the CronSchedule is not created from a cron string (e.g. with NewFromBuilder).
+	Expr string
+
+	clock Clock
 
 	logger  *slog.Logger
 	metrics Metrics
@@ -54,71 +100,440 @@ type CronSchedule struct {
 }
 
 // Next calculates and returns the following scheduled time, from the input time.Time.
+//
+// Occurrences that fall within a DST gap (e.g. 2:30 AM on a spring-forward day) take place on the first instant after
+// the gap. Wall-clock times that are repeated in a DST overlap (e.g. 1:30 AM on a fall-back day) only take place once,
+// unless the Schedule's hours are a wildcard.
+//
+// A zero time.Time is returned when the Schedule never triggers again: either because it constrains the year and all
+// of its years have already passed, or because it describes an impossible date (e.g. `0 0 30 2 *`, February 30th).
+// The search for an occurrence is bounded to the Schedule's last year if it constrains the year, or to the following
+// searchYears otherwise.
 func (s *CronSchedule) Next(ctx context.Context, t time.Time) time.Time {
 	ctx, span := s.tracer.Start(ctx, "Scheduler.Next")
 	defer span.End()
 
 	s.metrics.IncSchedulerNextCalls()
 
+	next := s.next(resolveTime(s.clock, t))
+
+	if next.IsZero() {
+		span.SetAttributes(attribute.Bool("no_occurrence", true))
+		s.logger.WarnContext(ctx, "no further occurrences in schedule")
+
+		return next
+	}
+
+	span.SetAttributes(attribute.String("at", next.Format(time.RFC3339)))
+	s.logger.InfoContext(ctx, "next job", s.logAttrs(next)...)
+
+	return next
+}
+
+// logAttrs returns the attributes for the log record of the input next scheduled time, including the Schedule's
+// time.Location and the cron string it was parsed from, when available.
+func (s *CronSchedule) logAttrs(next time.Time) []any {
+	attrs := []any{slog.Time("at", next), slog.String("location", s.Loc.String())}
+
+	if s.Expr != "" {
+		attrs = append(attrs, slog.String("schedule", s.Expr))
+	}
+
+	return attrs
+}
+
+// Until returns the duration from the input time.Time to the following scheduled time, as returned by Next.
+//
+// A zero or negative duration means that the schedule is due. If the Schedule never triggers again, Never is returned.
+func (s *CronSchedule) Until(ctx context.Context, t time.Time) time.Duration {
+	return until(ctx, s, resolveTime(s.clock, t))
+}
+
+// Prev calculates and returns the most recent scheduled time, at or before the input time.Time.
+//
+// If the Schedule constrains the year and all of its years are still ahead, the zero time.Time is returned.
+func (s *CronSchedule) Prev(ctx context.Context, t time.Time) time.Time {
+	ctx, span := s.tracer.Start(ctx, "Scheduler.Prev")
+	defer span.End()
+
+	prev := s.prev(resolveTime(s.clock, t))
+
+	if prev.IsZero() {
+		span.SetAttributes(attribute.Bool("no_occurrence", true))
+		s.logger.WarnContext(ctx, "no previous occurrences in schedule")
+
+		return prev
+	}
+
+	span.SetAttributes(attribute.String("at", prev.Format(time.RFC3339)))
+	s.logger.InfoContext(ctx, "previous job", slog.Time("at", prev))
+
+	return prev
+}
+
+// NextN calculates and returns the following n scheduled times, from the input time.Time, in a strictly increasing
+// order.
+//
+// If n is zero or below, an empty slice is returned. The returned slice may contain fewer than n elements if the
+// Schedule has no further occurrences. The number of occurrences is capped at maxOccurrences. A zero input time.Time is
+// resolved as the current time, from the Schedule's Clock.
+func (s *CronSchedule) NextN(ctx context.Context, from time.Time, n int) []time.Time {
+	ctx, span := s.tracer.Start(ctx, "Scheduler.NextN")
+	defer span.End()
+
+	if n <= 0 {
+		return []time.Time{}
+	}
+
+	n = min(n, maxOccurrences)
+	times := make([]time.Time, 0, n)
+
+	for next := s.next(resolveTime(s.clock, from)); !next.IsZero() && len(times) < n; next = s.next(next) {
+		// guard against any schedules that do not advance
+		if len(times) > 0 && !next.After(times[len(times)-1]) {
+			break
+		}
+
+		times = append(times, next)
+	}
+
+	span.SetAttributes(attribute.Int("num_occurrences", len(times)))
+	s.logger.InfoContext(ctx, "next jobs", slog.Int("num_occurrences", len(times)))
+
+	return times
+}
+
+// Upcoming returns an iterator over the following scheduled times, from the input time.Time, yielding them lazily in
+// a strictly increasing order.
+//
+// The returned function is compatible with iter.Seq[time.Time], and can be used in a range-over-func loop. The
+// iteration stops once the Schedule has no further occurrences, or when the input context.Context is done. A zero
+// input time.Time is resolved as the current time, from the Schedule's Clock, when the iteration starts.
+func (s *CronSchedule) Upcoming(ctx context.Context, from time.Time) func(yield func(time.Time) bool) {
+	return func(yield func(time.Time) bool) {
+		var prev time.Time
+
+		for next := s.next(resolveTime(s.clock, from)); !next.IsZero(); next = s.next(next) {
+			if ctx.Err() != nil {
+				return
+			}
+
+			// guard against any schedules that do not advance
+			if !prev.IsZero() && !next.After(prev) {
+				return
+			}
+
+			if !yield(next) {
+				return
+			}
+
+			prev = next
+		}
+	}
+}
+
+// next searches for the following occurrence after the input time, one schedule element at a time, from the year down
+// to the seconds. Whenever an element does not match, the candidate time moves forward to the start of the element's
+// next occurrence (resetting all lower elements), and the search starts over.
+//
+// The search is bounded by the Schedule's years (see searchLimit), returning a zero time.Time if no occurrence is
+// found.
+func (s *CronSchedule) next(t time.Time) time.Time {
+	// calculate the schedule elements in the context of the Scheduler's location
+	t = t.In(s.Loc).Truncate(time.Second).Add(time.Second)
+	limit := s.searchLimit(t, searchYears)
+
+	for t.Before(limit) {
+		// occurrences skipped by a DST gap (e.g. spring-forward) take place on the first instant after the gap
+		if s.skippedOccurrence(t) {
+			return t
+		}
+
+		// wall-clock times repeated by a DST overlap (e.g. fall-back) only take place once, unless the hour is a wildcard
+		if end, ok := repeatedWallClock(t); ok && !isEverytime(s.Schedule.Hour) {
+			t = end
+
+			continue
+		}
+
+		year, month, day := t.Date()
+		hour, minute, sec := t.Clock()
+		startOfMinute := t.Add(-time.Duration(sec) * time.Second)
+		startOfHour := startOfMinute.Add(-time.Duration(minute) * time.Minute)
+
+		if s.Schedule.Year != nil {
+			switch offset := s.Schedule.Year.Resolve(year); offset {
+			case resolve.NoOccurrence:
+				return time.Time{}
+			case 0:
+			default:
+				t = time.Date(year+offset, time.January, 1, 0, 0, 0, 0, s.Loc)
+
+				continue
+			}
+		}
+
+		switch {
+		case !matches(s.Schedule.Month, int(month)):
+			if m, ok := advance(s.Schedule.Month, int(month), maxMonth); ok {
+				t = time.Date(year, time.Month(m), 1, 0, 0, 0, 0, s.Loc)
+
+				continue
+			}
+
+			t = time.Date(year+1, time.January, 1, 0, 0, 0, 0, s.Loc)
+		case !s.matchesDay(t):
+			t = time.Date(year, month, day+1, 0, 0, 0, 0, s.Loc)
+		case !matches(s.Schedule.Hour, hour):
+			if h, ok := advance(s.Schedule.Hour, hour, maxHour); ok {
+				// advance in wall-clock hours, unless it would not move forward (within a DST overlap)
+				if next := time.Date(year, month, day, h, 0, 0, 0, s.Loc); next.After(t) {
+					t = next
+
+					continue
+				}
+
+				t = startOfHour.Add(time.Duration(h-hour) * time.Hour)
+
+				continue
+			}
+
+			t = time.Date(year, month, day+1, 0, 0, 0, 0, s.Loc)
+		case !matches(s.Schedule.Min, minute):
+			if m, ok := advance(s.Schedule.Min, minute, maxMin); ok {
+				t = startOfHour.Add(time.Duration(m) * time.Minute)
+
+				continue
+			}
+
+			t = startOfHour.Add(time.Hour)
+		case !matches(s.Schedule.Sec, sec):
+			if sc, ok := advance(s.Schedule.Sec, sec, maxSec); ok {
+				t = startOfMinute.Add(time.Duration(sc) * time.Second)
+
+				continue
+			}
+
+			t = startOfMinute.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
+// prev mirrors next, searching for the most recent occurrence at or before the input time. Whenever an element does
+// not match, the candidate time moves backwards to the end of the element's previous occurrence (setting all lower
+// elements to their last value), and the search starts over.
+//
+// The search is bounded by the Schedule's years (see searchLimit), returning a zero time.Time if no occurrence is
+// found.
+func (s *CronSchedule) prev(t time.Time) time.Time {
+	// calculate the schedule elements in the context of the Scheduler's location
+	t = t.In(s.Loc).Truncate(time.Second)
+	limit := s.searchLimit(t, -searchYears)
+
+	for t.After(limit) {
+		year, month, day := t.Date()
+		hour, minute, sec := t.Clock()
+		startOfMinute := t.Add(-time.Duration(sec) * time.Second)
+		startOfHour := startOfMinute.Add(-time.Duration(minute) * time.Minute)
+
+		if s.Schedule.Year != nil {
+			switch offset := s.Schedule.Year.ResolvePrev(year); offset {
+			case resolve.NoOccurrence:
+				return time.Time{}
+			case 0:
+			default:
+				t = time.Date(year-offset+1, time.January, 1, 0, 0, 0, 0, s.Loc).Add(-time.Second)
+
+				continue
+			}
+		}
+
+		switch {
+		case !matches(s.Schedule.Month, int(month)):
+			if m, ok := retreat(s.Schedule.Month, int(month), minMonth); ok {
+				t = time.Date(year, time.Month(m+1), 1, 0, 0, 0, 0, s.Loc).Add(-time.Second)
+
+				continue
+			}
+
+			t = time.Date(year, time.January, 1, 0, 0, 0, 0, s.Loc).Add(-time.Second)
+		case !s.matchesDay(t):
+			t = time.Date(year, month, day, 0, 0, 0, 0, s.Loc).Add(-time.Second)
+		case !matches(s.Schedule.Hour, hour):
+			if h, ok := retreat(s.Schedule.Hour, hour, 0); ok {
+				t = startOfHour.Add(-time.Duration(hour-h-1) * time.Hour).Add(-time.Second)
+
+				continue
+			}
+
+			t = time.Date(year, month, day, 0, 0, 0, 0, s.Loc).Add(-time.Second)
+		case !matches(s.Schedule.Min, minute):
+			if m, ok := retreat(s.Schedule.Min, minute, 0); ok {
+				t = startOfHour.Add(time.Duration(m+1) * time.Minute).Add(-time.Second)
+
+				continue
+			}
+
+			t = startOfHour.Add(-time.Second)
+		case !matches(s.Schedule.Sec, sec):
+			if sc, ok := retreat(s.Schedule.Sec, sec, 0); ok {
+				t = startOfMinute.Add(time.Duration(sc) * time.Second)
+
+				continue
+			}
+
+			t = startOfMinute.Add(-time.Second)
+		default:
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
+// searchLimit returns the bound for the search for an occurrence from the input time, in the input direction of
+// years: the end of the Schedule's last year when searching forward, or the start of its first year when searching
+// backwards (exclusive, one second before it).
+//
+// If the Schedule does not constrain the year to a resolve.YearSchedule, the search is bounded by the input number of
+// years from the input time instead.
+func (s *CronSchedule) searchLimit(t time.Time, years int) time.Time {
+	sched, ok := s.Schedule.Year.(resolve.YearSchedule)
+	if !ok || len(sched.Years) == 0 {
+		return t.AddDate(years, 0, 0)
+	}
+
+	if years < 0 {
+		return time.Date(slices.Min(sched.Years), time.January, 1, 0, 0, 0, 0, s.Loc).Add(-time.Second)
+	}
+
+	return time.Date(slices.Max(sched.Years)+1, time.January, 1, 0, 0, 0, 0, s.Loc)
+}
+
+// skippedOccurrence returns true if the input time is the first instant after a DST gap (e.g. spring-forward), where
+// the skipped wall-clock times contain an occurrence of the Schedule.
+func (s *CronSchedule) skippedOccurrence(t time.Time) bool {
+	start, _ := t.ZoneBounds()
+	if start.IsZero() || !t.Equal(start) {
+		return false
+	}
+
+	before := t.Add(-time.Second)
+
+	_, offset := t.Zone()
+	_, offsetBefore := before.Zone()
+
+	if offset <= offsetBefore {
+		return false
+	}
+
+	// search the skipped wall-clock times in UTC, where they are not affected by the gap
+	utc := &CronSchedule{Loc: time.UTC, Schedule: s.Schedule}
+	next := utc.next(wallClock(before))
+
+	return !next.IsZero() && next.Before(wallClock(t))
+}
+
+// repeatedWallClock returns true if the input time's wall-clock time has already taken place, as it is within a DST
+// overlap (e.g. fall-back). It also returns the end of the repeated period.
+func repeatedWallClock(t time.Time) (time.Time, bool) {
+	start, _ := t.ZoneBounds()
+	if start.IsZero() {
+		return time.Time{}, false
+	}
+
+	_, offset := t.Zone()
+	_, offsetBefore := start.Add(-time.Second).Zone()
+
+	if offset >= offsetBefore {
+		return time.Time{}, false
+	}
+
+	end := start.Add(time.Duration(offsetBefore-offset) * time.Second)
+
+	return end, t.Before(end)
+}
+
+// wallClock returns the input time's wall-clock time, represented in UTC.
+func wallClock(t time.Time) time.Time {
 	year, month, day := t.Date()
-	hour := t.Hour()
-	minute := t.Minute()
-	second := t.Second() + 1
+	hour, minute, sec := t.Clock()
 
-	nextSecond := s.Schedule.Sec.Resolve(second)
-	if s.Schedule.Sec == fixedSeconds {
-		nextSecond++
+	return time.Date(year, month, day, hour, minute, sec, 0, time.UTC)
+}
+
+func isEverytime(r cronlex.Resolver) bool {
+	_, ok := r.(resolve.Everytime)
+
+	return r == nil || ok
+}
+
+// matchesDay returns true if the input time's day is an occurrence of the Schedule.
+//
+// Following crontab(5), if both the day-of-month and day-of-week are restricted (not a wildcard), the day is an
+// occurrence if it matches either of them. Otherwise, it must match both.
+func (s *CronSchedule) matchesDay(t time.Time) bool {
+	dayMonth := matchesDayOfMonth(s.Schedule.DayMonth, t)
+	dayWeek := matches(s.Schedule.DayWeek, int(t.Weekday()))
+
+	if !isEverytime(s.Schedule.DayMonth) && !isEverytime(s.Schedule.DayWeek) {
+		return dayMonth || dayWeek
 	}
 
-	nextMinute := s.Schedule.Min.Resolve(minute)
-	nextHour := s.Schedule.Hour.Resolve(hour)
-	nextDay := s.Schedule.DayMonth.Resolve(day)
-	nextMonth := s.Schedule.Month.Resolve(int(month))
+	return dayMonth && dayWeek
+}
 
-	// time.Date automatically normalizes overflowing values in the context of dates
-	// (e.g. a result containing 27 hours is 3 AM on the next day)
-	dayOfMonthTime := time.Date(
-		year,
-		month+time.Month(nextMonth),
-		day+nextDay,
-		hour+nextHour,
-		minute+nextMinute,
-		second+nextSecond,
-		0, s.Loc,
-	)
+// dayResolver describes a Resolver whose days of the month depend on the length of the month, like the
+// resolve.FromEndSchedule.
+type dayResolver interface {
+	ContainsDay(day, daysInMonth int) bool
+}
 
-	// short circuit if unset or star '*'
-	if _, ok := (s.Schedule.DayWeek).(resolve.Everytime); s.Schedule.DayWeek == nil || ok {
-		span.SetAttributes(attribute.String("at", dayOfMonthTime.Format(time.RFC3339)))
-		s.logger.InfoContext(ctx, "next job", slog.Time("at", dayOfMonthTime))
+// matchesDayOfMonth returns true if the input time's day is an occurrence of the input day-of-the-month Resolver,
+// taking into account the length of the input time's month.
+func matchesDayOfMonth(r cronlex.Resolver, t time.Time) bool {
+	if dr, ok := r.(dayResolver); ok {
+		year, month, _ := t.Date()
+		// the zeroth day of the following month is the last day of this month
+		daysInMonth := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
 
-		return dayOfMonthTime
+		return dr.ContainsDay(t.Day(), daysInMonth)
 	}
 
-	curWeekday := dayOfMonthTime.Weekday()
-	nextWeekday := s.Schedule.DayWeek.Resolve(int(curWeekday))
+	return matches(r, t.Day())
+}
 
-	weekdayTime := time.Date(
-		dayOfMonthTime.Year(),
-		dayOfMonthTime.Month(),
-		dayOfMonthTime.Day()+nextWeekday,
-		dayOfMonthTime.Hour(),
-		dayOfMonthTime.Minute(),
-		dayOfMonthTime.Second(),
-		0, s.Loc,
-	)
+// matches returns true if the input value is an occurrence of the Resolver. An unset Resolver matches any value.
+func matches(r cronlex.Resolver, value int) bool {
+	return r == nil || r.Contains(value)
+}
 
-	span.SetAttributes(attribute.String("at", weekdayTime.Format(time.RFC3339)))
-	s.logger.InfoContext(ctx, "next job", slog.Time("at", weekdayTime))
+// advance returns the Resolver's next occurrence from the input value, and whether it is within the maximum value.
+// If not, the occurrence only takes place after the Resolver wraps around.
+func advance(r cronlex.Resolver, value, maximum int) (int, bool) {
+	next := value + r.Resolve(value)
 
-	return weekdayTime
+	return next, next <= maximum
+}
+
+// retreat returns the Resolver's previous occurrence from the input value, and whether it is within the minimum value.
+// If not, the occurrence only takes place before the Resolver wraps around.
+func retreat(r cronlex.Resolver, value, minimum int) (int, bool) {
+	prev := value - r.ResolvePrev(value)
+
+	return prev, prev >= minimum
 }
 
 // New creates a Scheduler with the input cfg.Option(s), also returning an error if raised.
 //
 // Creating a Scheduler requires the caller to provide at least a cron string, using the WithSchedule option.
 //
-// If a time.Location is not specified with the WithLocation option, then time.Local is used.
+// The cron string may embed a time.Location with a leading `TZ=` or `CRON_TZ=` prefix, which takes precedence over
+// the WithLocation option. If a time.Location is not specified in either, then time.Local is used.
 func New(options ...cfg.Option[Config]) (Scheduler, error) {
 	config := cfg.Set(defaultConfig(), options...)
 
@@ -130,20 +545,99 @@ func New(options ...cfg.Option[Config]) (Scheduler, error) {
 	return cron, nil
 }
 
+// Next parses the input cron string and returns its following scheduled time from the input time.Time, in a single
+// call. It is a shorthand for creating a Scheduler with New, for scripts and tools that only need one occurrence.
+//
+// The schedule uses the input time.Location, or time.Local if it is nil, unless the cron string embeds one with a
+// `TZ=` or `CRON_TZ=` prefix. A zero time.Time is resolved as the current time. If the cron string cannot be parsed,
+// its parse error is returned; if it has no further occurrences, a zero time.Time is returned with a nil error.
+func Next(cron string, loc *time.Location, from time.Time) (time.Time, error) {
+	sched, err := New(WithSchedule(cron), WithLocation(loc))
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return sched.Next(context.Background(), from), nil
+}
+
+// NewFromBuilder creates a CronSchedule from the input builder.Resolver(s), as built with builder.Build, also returning
+// an error if raised. It is meant for schedules defined programmatically with the builder package instead of a cron
+// string.
+//
+// The CronSchedule uses the input time.Location, or time.Local if it is nil. Its logs, metrics and traces are no-ops,
+// which can be replaced with AddLogs, AddMetrics and AddTraces.
+func NewFromBuilder(loc *time.Location, resolvers ...builder.Resolver) (*CronSchedule, error) {
+	sched, err := builder.Build(resolvers...)
+	if err != nil {
+		return nil, err
+	}
+
+	if loc == nil {
+		loc = time.Local
+	}
+
+	config := defaultConfig()
+
+	return &CronSchedule{
+		Loc:      loc,
+		Schedule: *sched,
+		clock:    config.clock,
+
+		logger:  slog.New(config.handler),
+		metrics: config.metrics,
+		tracer:  config.tracer,
+	}, nil
+}
+
 func newScheduler(config Config) (Scheduler, error) {
 	// parse cron string
-	sched, err := cronlex.Parse(config.cron)
+	sched, loc, err := cronlex.ParseWithLocationAndWeekStart(config.cron, config.weekStart)
 	if err != nil {
 		return noOpScheduler{}, err
 	}
 
+	// a location embedded in the cron string takes precedence
+	if loc != nil {
+		config.loc = loc
+	}
+
 	if config.loc == nil {
 		config.loc = time.Local
 	}
 
+	if sched.Once {
+		return &OnceSchedule{
+			Loc:   config.loc,
+			clock: config.clock,
+
+			logger:  slog.New(config.handler),
+			metrics: config.metrics,
+			tracer:  config.tracer,
+		}, nil
+	}
+
+	if sched.Every > 0 {
+		return &IntervalSchedule{
+			Loc:     config.loc,
+			Every:   sched.Every,
+			Aligned: config.aligned,
+			clock:   config.clock,
+
+			logger:  slog.New(config.handler),
+			metrics: config.metrics,
+			tracer:  config.tracer,
+		}, nil
+	}
+
+	if config.secondsDisabled {
+		sched.Sec = resolve.FixedSchedule{Max: maxSec, At: 0}
+	}
+
 	return &CronSchedule{
 		Loc:      config.loc,
 		Schedule: sched,
+		Expr:     config.cron,
+		clock:    config.clock,
 
 		logger:  slog.New(config.handler),
 		metrics: config.metrics,
@@ -151,6 +645,18 @@ func newScheduler(config Config) (Scheduler, error) {
 	}, nil
 }
 
+// until returns the duration from the input time.Time to the input Scheduler's following scheduled time, or Never if
+// it has no further occurrences. The input time.Time is expected to be already resolved (see resolveTime), so that the
+// same instant is used both in the Next call and in the subtraction.
+func until(ctx context.Context, s Scheduler, now time.Time) time.Duration {
+	next := s.Next(ctx, now)
+	if next.IsZero() {
+		return Never
+	}
+
+	return next.Sub(now)
+}
+
 func NoOp() Scheduler {
 	return noOpScheduler{}
 }
@@ -160,3 +666,11 @@ type noOpScheduler struct{}
 func (s noOpScheduler) Next(_ context.Context, _ time.Time) time.Time {
 	return time.Time{}
 }
+
+func (s noOpScheduler) Prev(_ context.Context, _ time.Time) time.Time {
+	return time.Time{}
+}
+
+func (s noOpScheduler) Until(_ context.Context, _ time.Time) time.Duration {
+	return Never
+}
@@ -16,6 +16,12 @@ type Config struct {
 	cron string
 	loc  *time.Location
 
+	secondsDisabled bool
+	aligned         bool
+	weekStart       time.Weekday
+
+	clock Clock
+
 	handler slog.Handler
 	metrics Metrics
 	tracer  trace.Tracer
@@ -23,6 +29,7 @@ type Config struct {
 
 func defaultConfig() Config {
 	return Config{
+		clock:   realClock{},
 		handler: log.NoOp(),
 		metrics: metrics.NoOp(),
 		tracer:  noop.NewTracerProvider().Tracer("scheduler's no-op tracer"),
@@ -31,6 +38,9 @@ func defaultConfig() Config {
 
 // WithSchedule configures the Scheduler with the input cron string.
 //
+// The cron string may be prefixed with a `TZ=` or `CRON_TZ=` location (e.g. `CRON_TZ=America/New_York 0 9 * * *`),
+// which is used as the Scheduler's time.Location.
+//
 // This call returns a cfg.NoOp cfg.Option if the input cron string is empty.
 func WithSchedule(cron string) cfg.Option[Config] {
 	if cron == "" {
@@ -59,6 +69,75 @@ func WithLocation(loc *time.Location) cfg.Option[Config] {
 	})
 }
 
+// WithSecondsDisabled configures the Scheduler to ignore the seconds field of its cron string, always triggering on the
+// first second of a minute (like classic cron). For example, `* * * * * *` triggers once per minute instead of once
+// per second.
+//
+// This option only affects cron schedules. `@every` interval schedules are kept as-is.
+func WithSecondsDisabled() cfg.Option[Config] {
+	return cfg.Register(func(config Config) Config {
+		config.secondsDisabled = true
+
+		return config
+	})
+}
+
+// WithAlignment configures whether the occurrences of an `@every` interval schedule are aligned to the wall clock. If
+// aligned, they snap to the interval's boundaries: `@every 5m` evaluated at 10:02 triggers at 10:05. Otherwise, which
+// is the default, the first occurrence is offset from the input time of the first Next call, and the following ones
+// are anchored on it: the same schedule triggers at 10:07, 10:12 and so on, however often Next is called in between.
+// A gap of more than one interval between calls (e.g. when the process was asleep) re-anchors the occurrences on the
+// input time.
+//
+// Boundaries are multiples of the interval on the Scheduler's time.Location wall clock, so intervals that evenly divide
+// a day (like 5m, 15m or 1h) fall on the same times every day, counted from midnight.
+//
+// This option only affects `@every` interval schedules. Cron schedules are always aligned to their fields (e.g.
+// `*/5 * * * *` triggers on minutes 0, 5, 10 and so on), regardless of this option.
+func WithAlignment(aligned bool) cfg.Option[Config] {
+	return cfg.Register(func(config Config) Config {
+		config.aligned = aligned
+
+		return config
+	})
+}
+
+// WithWeekStart configures the day that numeric values in the days-of-the-week field of the cron string are counted
+// from, which is Sunday by default. For example, with a time.Monday week start, `0-4` means Monday to Friday and `6`
+// means Sunday. Names of the days of the week (like `MON`) are not affected. See cronlex.ParseWithWeekStart for details.
+//
+// The resulting Scheduler still resolves days of the week as time.Weekday values, so its occurrences are not otherwise
+// affected by this option.
+//
+// This call returns a cfg.NoOp cfg.Option if the input time.Weekday is not a valid day of the week.
+func WithWeekStart(start time.Weekday) cfg.Option[Config] {
+	if start < time.Sunday || start > time.Saturday {
+		return cfg.NoOp[Config]{}
+	}
+
+	return cfg.Register(func(config Config) Config {
+		config.weekStart = start
+
+		return config
+	})
+}
+
+// WithClock configures the Clock used by the Scheduler to get the current time, when its Next or Prev methods are
+// called with a zero time.Time. The default Clock uses time.Now.
+//
+// This call returns a cfg.NoOp cfg.Option if the input Clock is nil.
+func WithClock(clock Clock) cfg.Option[Config] {
+	if clock == nil {
+		return cfg.NoOp[Config]{}
+	}
+
+	return cfg.Register(func(config Config) Config {
+		config.clock = clock
+
+		return config
+	})
+}
+
 // WithMetrics decorates the Scheduler with the input metrics registry.
 func WithMetrics(m Metrics) cfg.Option[Config] {
 	if m == nil {
@@ -24,12 +24,20 @@ func AddLogs(s Scheduler, handler slog.Handler) Scheduler {
 		return NoOp()
 	}
 
-	sched, ok := s.(*CronSchedule)
-	if !ok {
-		return s
-	}
+	switch sched := s.(type) {
+	case *CronSchedule:
+		sched.logger = slog.New(handler)
+
+		return sched
+	case *IntervalSchedule:
+		sched.logger = slog.New(handler)
 
-	sched.logger = slog.New(handler)
+		return sched
+	case *OnceSchedule:
+		sched.logger = slog.New(handler)
 
-	return sched
+		return sched
+	default:
+		return s
+	}
 }
@@ -22,12 +22,20 @@ func AddMetrics(s Scheduler, m Metrics) Scheduler {
 		return NoOp()
 	}
 
-	sched, ok := s.(*CronSchedule)
-	if !ok {
-		return s
-	}
+	switch sched := s.(type) {
+	case *CronSchedule:
+		sched.metrics = m
+
+		return sched
+	case *IntervalSchedule:
+		sched.metrics = m
 
-	sched.metrics = m
+		return sched
+	case *OnceSchedule:
+		sched.metrics = m
 
-	return sched
+		return sched
+	default:
+		return s
+	}
 }
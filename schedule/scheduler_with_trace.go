@@ -23,12 +23,20 @@ func AddTraces(s Scheduler, tracer trace.Tracer) Scheduler {
 		return NoOp()
 	}
 
-	sched, ok := s.(*CronSchedule)
-	if !ok {
-		return s
-	}
+	switch sched := s.(type) {
+	case *CronSchedule:
+		sched.tracer = tracer
+
+		return sched
+	case *IntervalSchedule:
+		sched.tracer = tracer
 
-	sched.tracer = tracer
+		return sched
+	case *OnceSchedule:
+		sched.tracer = tracer
 
-	return sched
+		return sched
+	default:
+		return s
+	}
 }
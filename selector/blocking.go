@@ -2,7 +2,10 @@ package selector
 
 import (
 	"context"
+	"errors"
 	"log/slog"
+	"slices"
+	"sync"
 	"time"
 
 	"go.opentelemetry.io/otel/codes"
@@ -12,8 +15,17 @@ import (
 )
 
 type blockingSelector struct {
+	mu   sync.RWMutex
 	exec []executor.Executor
 
+	// sampler skips a ratio of the executions, when set
+	sampler *sampler
+
+	clock Clock
+
+	// timeout limits how long each execution may run past its scheduled time, when set
+	timeout time.Duration
+
 	logger  *slog.Logger
 	metrics Metrics
 	tracer  trace.Tracer
@@ -28,8 +40,29 @@ type blockingSelector struct {
 // The Selector allows multiple executor.Executor to be configured, and multiple executor.Executor can share similar
 // execution times. If that is the case, the executor is launched in an executor.Multi call.
 //
+// Co-scheduled executors are launched (and their IDs returned by NextSelected) in the order of their IDs.
+//
+// If the Selector is configured with a timeout, the execution is limited to that duration past its scheduled time.
+// When it is exceeded, Next returns context.DeadlineExceeded without waiting further for the execution.
+//
 // The error returned from a Next call is the error raised by the executor.Executor's Exec call.
 func (s *blockingSelector) Next(ctx context.Context) error {
+	_, err := s.NextSelected(ctx)
+
+	return err
+}
+
+// NextSelected works like Next, while also returning the IDs of the executor.Executor(s) that it triggered.
+func (s *blockingSelector) NextSelected(ctx context.Context) ([]string, error) {
+	return s.nextSelected(ctx, earliest)
+}
+
+// nextSelected runs the executor.Executor(s) chosen by the input pick function, out of the Selector's set of
+// executors, returning their IDs and the error raised by their execution.
+func (s *blockingSelector) nextSelected(
+	ctx context.Context,
+	pick func(ctx context.Context, clock Clock, execs []executor.Executor) []executor.Executor,
+) ([]string, error) {
 	ctx, span := s.tracer.Start(ctx, "Selector.Select")
 	defer span.End()
 
@@ -38,17 +71,32 @@ func (s *blockingSelector) Next(ctx context.Context) error {
 
 	// minStepDuration ensures that each execution is locked to the seconds mark and
 	// a runner is not executed more than once per trigger.
-	defer time.Sleep(minStepDuration)
+	defer sleep(ctx, minStepDuration)
 
-	var err error
+	var (
+		err   error
+		start = time.Now()
+		execs = s.executors()
+	)
 
-	switch len(s.exec) {
-	case 0:
+	switch {
+	case len(execs) == 0:
 		err = ErrEmptyExecutorsList
-	case 1:
-		err = s.exec[0].Exec(ctx)
+	case len(execs) == 1 && !isDisabled(execs[0]):
+		s.metrics.ObserveSelectLatency(ctx, time.Since(start))
+		err = s.execTimeout(ctx, execs)
 	default:
-		err = executor.Multi(ctx, s.next(ctx)...)
+		// a single disabled executor.Executor also goes through pick, so that it waits for its scheduled time
+		execs = pick(ctx, s.clock, execs)
+		s.metrics.ObserveSelectLatency(ctx, time.Since(start))
+		err = s.execTimeout(ctx, execs)
+	}
+
+	if errors.Is(err, ErrExhaustedExecutorsList) {
+		span.AddEvent("no further occurrences")
+		s.logger.InfoContext(ctx, "no tasks left with further executions")
+
+		return nil, err
 	}
 
 	if err != nil {
@@ -59,42 +107,86 @@ func (s *blockingSelector) Next(ctx context.Context) error {
 			slog.String("error", err.Error()),
 		)
 
-		return err
+		return executorIDs(execs), err
 	}
 
-	return nil
+	return executorIDs(execs), nil
 }
 
-func (s *blockingSelector) next(ctx context.Context) []executor.Executor {
-	var (
-		next time.Duration
-		exec = make([]executor.Executor, 0, len(s.exec))
-		now  = time.Now()
-	)
-
-	for i := range s.exec {
-		t := s.exec[i].Next(ctx).Sub(now)
+// execTimeout runs the input executor.Executor(s), waiting for them to complete. If the Selector has a timeout, the
+// executions are given a context.Context with a deadline of their scheduled time plus the timeout, and this call
+// returns the context.Context's error once it is done, detaching from any runners that ignore the cancellation.
+func (s *blockingSelector) execTimeout(ctx context.Context, execs []executor.Executor) error {
+	execs = s.sampler.wrap(execs, s.logger, s.metrics)
 
-		switch {
-		case i == 0:
-			next = t
+	if s.timeout <= 0 || len(execs) == 0 {
+		return exec(ctx, execs)
+	}
 
-			exec = append(exec, s.exec[i])
+	// the timeout starts at the scheduled time, as the executor.Executor's Exec call waits for it
+	deadline := time.Now().Add(s.timeout)
+	if wait := execs[0].Next(ctx).Sub(now(s.clock)); wait > 0 {
+		deadline = deadline.Add(wait)
+	}
 
-			continue
-		case t == next:
-			exec = append(exec, s.exec[i])
+	timeoutCtx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
 
-			continue
-		case t < next:
-			next = t
-			exec = make([]executor.Executor, 0, len(s.exec))
+	// buffered, so that a detached execution does not block when it completes
+	errCh := make(chan error, 1)
 
-			exec = append(exec, s.exec[i])
+	go func() {
+		errCh <- exec(timeoutCtx, execs)
+	}()
 
-			continue
-		}
+	select {
+	case <-timeoutCtx.Done():
+		return timeoutCtx.Err()
+	case err := <-errCh:
+		return err
 	}
+}
+
+// Add includes the input executor.Executor(s) in the Selector's set of executors. Nil and no-op executors are
+// ignored.
+//
+// This call is safe to use concurrently with Next, where the changes are applied on the following Next call.
+func (s *blockingSelector) Add(execs ...executor.Executor) {
+	s.mu.Lock()
+	s.exec = addExecutors(s.exec, execs...)
+	s.metrics.ObserveRegisteredExecutors(len(s.exec))
+	s.mu.Unlock()
+}
+
+// Remove excludes any executor.Executor whose ID matches the input id from the Selector's set of executors.
+//
+// This call is safe to use concurrently with Next, where the changes are applied on the following Next call.
+func (s *blockingSelector) Remove(id string) {
+	s.mu.Lock()
+	s.exec = removeExecutor(s.exec, id)
+	s.metrics.ObserveRegisteredExecutors(len(s.exec))
+	s.mu.Unlock()
+}
+
+// Executors returns the Selector's current set of executor.Executor.
+//
+// The returned slice is a copy that the caller is free to modify. This call is safe to use concurrently with Next,
+// Add and Remove.
+func (s *blockingSelector) Executors() []executor.Executor {
+	return slices.Clone(s.executors())
+}
+
+// Schedule returns the ID and next execution time of each of the Selector's executor.Executor, sorted by their next
+// execution time. Executors without further occurrences are listed last, with a zero time.
+//
+// This call does not trigger any execution.
+func (s *blockingSelector) Schedule(ctx context.Context) []JobSchedule {
+	return schedule(ctx, s.executors())
+}
+
+func (s *blockingSelector) executors() []executor.Executor {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 
-	return exec
+	return s.exec
 }
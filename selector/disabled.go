@@ -0,0 +1,63 @@
+package selector
+
+import (
+	"context"
+	"time"
+
+	"github.com/zalgonoise/micron/executor"
+)
+
+// enabler describes an executor.Executor that can be disabled, like the executor.Executable.
+type enabler interface {
+	Enabled() bool
+}
+
+// isDisabled returns true if the input executor.Executor can be disabled, and is currently disabled.
+func isDisabled(exec executor.Executor) bool {
+	e, ok := exec.(enabler)
+
+	return ok && !e.Enabled()
+}
+
+// disabledExecutor is an executor.Executor that is disabled, which is selected only when all executors with further
+// occurrences are disabled. Its Exec call waits for its scheduled time without running the task, so that the Selector
+// does not spin over executors that return immediately; then skips the execution if it is still disabled.
+type disabledExecutor struct {
+	executor.Executor
+
+	clock Clock
+}
+
+// Exec waits for the task's scheduled time, and lets the executor.Executor register the skipped execution if it is
+// still disabled. If it has been enabled in the meantime, it is picked up in the Selector's following selection.
+func (e disabledExecutor) Exec(ctx context.Context) error {
+	if err := waitNext(ctx, e.clock, e.Executor); err != nil {
+		return err
+	}
+
+	if !isDisabled(e.Executor) {
+		return nil
+	}
+
+	return e.Executor.Exec(ctx)
+}
+
+// waitNext waits for the input executor.Executor's next execution time, from the input Clock's current time. It returns
+// executor.ErrExhaustedScheduler if it has no further occurrences, or the context.Context's error if it is done before
+// then.
+func waitNext(ctx context.Context, clock Clock, exec executor.Executor) error {
+	next := exec.Next(ctx)
+	if next.IsZero() {
+		return executor.ErrExhaustedScheduler
+	}
+
+	timer := time.NewTimer(next.Sub(now(clock)))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
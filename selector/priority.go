@@ -0,0 +1,81 @@
+package selector
+
+import (
+	"cmp"
+	"context"
+	"log/slog"
+	"slices"
+
+	"github.com/zalgonoise/micron/executor"
+)
+
+// prioritySelector is a blocking Selector that breaks ties between executor.Executor(s) due at the same time by their
+// priority: only the executor.Executor(s) with the highest priority take the slot, and are executed.
+type prioritySelector struct {
+	*blockingSelector
+
+	priorities map[string]int
+}
+
+// Next picks up the following scheduled job to execute from its configured (set of) executor.Executor, and
+// calls its Exec method.
+//
+// When multiple executor.Executor share the same next execution time, only the ones with the highest priority are
+// executed, while the remaining ones skip that occurrence. Each skipped executor.Executor is registered with the
+// Selector's Metrics, with the executor.SkipReasonPriority reason.
+//
+// This call also imposes a minimum step duration of 50ms, to ensure that early-runs are not executed twice due to the
+// nature of using clocks in Go. This sleep is deferred to come in after the actual execution of the job.
+//
+// The error returned from a Next call is the error raised by the executor.Executor's Exec call.
+func (s *prioritySelector) Next(ctx context.Context) error {
+	_, err := s.NextSelected(ctx)
+
+	return err
+}
+
+// NextSelected works like Next, while also returning the IDs of the executor.Executor(s) that it triggered.
+func (s *prioritySelector) NextSelected(ctx context.Context) ([]string, error) {
+	return s.nextSelected(ctx, s.highest)
+}
+
+// highest returns the executor.Executor(s) with the highest priority, out of the ones with the nearest next execution
+// time. The remaining executor.Executor(s) due at that time are registered as skipped runs.
+func (s *prioritySelector) highest(ctx context.Context, clock Clock, execs []executor.Executor) []executor.Executor {
+	execs = byPriority(earliest(ctx, clock, execs), s.priorities)
+	if len(execs) <= 1 {
+		return execs
+	}
+
+	top := s.priorities[execs[0].ID()]
+	n := 1
+
+	for n < len(execs) && s.priorities[execs[n].ID()] == top {
+		n++
+	}
+
+	for i := n; i < len(execs); i++ {
+		s.metrics.IncExecutorSkippedRuns(execs[i].ID(), executor.SkipReasonPriority)
+		s.logger.InfoContext(ctx, "skipping task execution, outranked by a higher priority task",
+			slog.String("id", execs[i].ID()),
+			slog.Int("priority", s.priorities[execs[i].ID()]),
+			slog.Int("top_priority", top),
+		)
+	}
+
+	return execs[:n]
+}
+
+// byPriority sorts the input executor.Executor(s) by their priority in the input map, from highest to lowest. Executors
+// with the same priority keep their relative order, and executors missing from the map have a priority of zero.
+func byPriority(execs []executor.Executor, priorities map[string]int) []executor.Executor {
+	if len(priorities) == 0 || len(execs) <= 1 {
+		return execs
+	}
+
+	slices.SortStableFunc(execs, func(a, b executor.Executor) int {
+		return cmp.Compare(priorities[b.ID()], priorities[a.ID()])
+	})
+
+	return execs
+}
@@ -0,0 +1,132 @@
+package selector
+
+import (
+	"context"
+	"log/slog"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/zalgonoise/micron/executor"
+)
+
+// sampler decides whether a due executor.Executor actually runs, running only a ratio of the executions and skipping
+// the rest (e.g. to canary a new job definition).
+type sampler struct {
+	ratio float64
+
+	// mu guards rng and decisions, as executions are decided concurrently in executor.Multi calls
+	mu  sync.Mutex
+	rng *rand.Rand
+
+	// decisions keeps the last sampling decision for each executor.Executor ID, so that the same occurrence is not
+	// sampled again when a non-blocking Selector re-polls an execution it detached from
+	decisions map[string]decision
+
+	// clock is the source of the current time when waiting for the scheduled time of a skipped execution
+	clock Clock
+}
+
+// wrap wraps the input executor.Executor(s) so that their executions are sampled, registering skipped executions with
+// the input logger and Metrics. A nil sampler returns the input executor.Executor(s) as-is.
+func (s *sampler) wrap(execs []executor.Executor, logger *slog.Logger, m Metrics) []executor.Executor {
+	if s == nil {
+		return execs
+	}
+
+	sampled := make([]executor.Executor, 0, len(execs))
+
+	for i := range execs {
+		sampled = append(sampled, sampledExecutor{
+			Executor: execs[i],
+			sampler:  s,
+			logger:   logger,
+			metrics:  m,
+		})
+	}
+
+	return sampled
+}
+
+// decision is the sampling decision for an executor.Executor's occurrence.
+type decision struct {
+	at  time.Time
+	run bool
+	// skipped is set once the skipped occurrence is registered, so that it is only registered once
+	skipped bool
+}
+
+// run returns true if the occurrence of the executor.Executor with the input ID, scheduled for the input time, should
+// run according to the sampler's ratio. The decision is made once per occurrence, and repeated on subsequent calls.
+func (s *sampler) run(id string, at time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if d, ok := s.decisions[id]; ok && d.at.Equal(at) {
+		return d.run
+	}
+
+	if s.decisions == nil {
+		s.decisions = make(map[string]decision)
+	}
+
+	d := decision{at: at, run: s.rng.Float64() < s.ratio}
+	s.decisions[id] = d
+
+	return d.run
+}
+
+// skip returns true if the skipped occurrence of the executor.Executor with the input ID, scheduled for the input
+// time, is yet to be registered, marking it as registered.
+func (s *sampler) skip(id string, at time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d, ok := s.decisions[id]
+	if !ok || !d.at.Equal(at) || d.skipped {
+		return false
+	}
+
+	d.skipped = true
+	s.decisions[id] = d
+
+	return true
+}
+
+// sampledExecutor is an executor.Executor that only runs a ratio of its executions. A skipped execution still waits for
+// its scheduled time, so that the trigger is consumed just like when it runs.
+type sampledExecutor struct {
+	executor.Executor
+
+	sampler *sampler
+	logger  *slog.Logger
+	metrics Metrics
+}
+
+// Exec runs the task when on its scheduled time, if it is sampled to run.
+//
+// Each occurrence is sampled once, even if Exec is called multiple times while waiting for it (like when a
+// non-blocking Selector detaches from the execution and selects it again), and a skipped occurrence is only
+// registered once.
+func (e sampledExecutor) Exec(ctx context.Context) error {
+	at := e.Executor.Next(ctx)
+	if at.IsZero() || e.sampler.run(e.ID(), at) {
+		return e.Executor.Exec(ctx)
+	}
+
+	if err := waitNext(ctx, e.sampler.clock, e.Executor); err != nil {
+		return err
+	}
+
+	if !e.sampler.skip(e.ID(), at) {
+		return nil
+	}
+
+	e.metrics.IncExecutorSkippedRuns(e.ID(), executor.SkipReasonSampling)
+	e.logger.InfoContext(ctx, "skipping task execution, not sampled to run",
+		slog.String("id", e.ID()),
+		slog.Float64("ratio", e.sampler.ratio),
+	)
+
+	return nil
+}
@@ -2,7 +2,12 @@ package selector
 
 import (
 	"context"
+	"errors"
 	"log/slog"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/zalgonoise/cfg"
@@ -19,12 +24,16 @@ const (
 
 	errSelectorDomain = errs.Domain("micron/selector")
 
-	ErrEmpty = errs.Kind("empty")
+	ErrEmpty     = errs.Kind("empty")
+	ErrExhausted = errs.Kind("exhausted")
 
 	ErrExecutorsList = errs.Entity("executors list")
 )
 
-var ErrEmptyExecutorsList = errs.WithDomain(errSelectorDomain, ErrEmpty, ErrExecutorsList)
+var (
+	ErrEmptyExecutorsList     = errs.WithDomain(errSelectorDomain, ErrEmpty, ErrExecutorsList)
+	ErrExhaustedExecutorsList = errs.WithDomain(errSelectorDomain, ErrExhausted, ErrExecutorsList)
+)
 
 // Selector describes the capabilities of a cron selector, which picks up the next job to execute (out of a set of
 // executor.Executor)
@@ -47,8 +56,83 @@ type Selector interface {
 	// The Selector allows multiple executor.Executor to be configured, and multiple executor.Executor can share similar
 	// execution times. If that is the case, the executor is launched in an executor.Multi call.
 	//
+	// Co-scheduled executors are launched (and their IDs returned by NextSelected) in the order of their IDs.
+	//
 	// The error returned from a Next call is the error raised by the executor.Executor's Exec call.
+	//
+	// Executors without further occurrences (with a zero next time) are skipped. If none of the executors have further
+	// occurrences, ErrExhaustedExecutorsList is returned, signaling that the Selector is done.
 	Next(ctx context.Context) error
+
+	// NextSelected works like Next, while also returning the IDs of the executor.Executor(s) that it triggered, for
+	// callers that need to record which jobs ran in each call (like an audit log).
+	//
+	// The returned IDs are empty when no executor.Executor was triggered, like when the Selector has no executors with
+	// further occurrences.
+	NextSelected(ctx context.Context) ([]string, error)
+
+	// Add includes the input executor.Executor(s) in the Selector's set of executors. Nil and no-op executors are
+	// ignored.
+	//
+	// This call is safe to use concurrently with Next, where the changes are applied on the following Next call.
+	Add(execs ...executor.Executor)
+
+	// Remove excludes any executor.Executor whose ID matches the input id from the Selector's set of executors.
+	//
+	// This call is safe to use concurrently with Next, where the changes are applied on the following Next call.
+	Remove(id string)
+
+	// Executors returns the Selector's current set of executor.Executor.
+	//
+	// The returned slice is a copy that the caller is free to modify. This call is safe to use concurrently with Next,
+	// Add and Remove.
+	Executors() []executor.Executor
+
+	// Schedule returns the ID and next execution time of each of the Selector's executor.Executor, sorted by their next
+	// execution time. Executors without further occurrences are listed last, with a zero time.
+	//
+	// This call does not trigger any execution.
+	Schedule(ctx context.Context) []JobSchedule
+}
+
+// JobSchedule describes when an executor.Executor, identified by its ID, is due to execute next. A zero Next time
+// means that the executor.Executor has no further occurrences.
+type JobSchedule struct {
+	ID   string
+	Next time.Time
+}
+
+// Clock describes a source for the current time, which can be replaced with a fake one in tests and simulations.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+}
+
+type realClock struct{}
+
+// Now returns the current time, with time.Now.
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// now returns the current time from the input Clock, defaulting to time.Now if it is nil.
+func now(clock Clock) time.Time {
+	if clock == nil {
+		return time.Now()
+	}
+
+	return clock.Now()
+}
+
+// sleep waits for the input duration, returning early if the context is done.
+func sleep(ctx context.Context, d time.Duration) {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
 }
 
 // Metrics describes the actions that register Selector-related metrics.
@@ -57,11 +141,38 @@ type Metrics interface {
 	IncSelectorSelectCalls()
 	// IncSelectorSelectErrors increases the count of Select call errors, by the Selector.
 	IncSelectorSelectErrors()
+	// IncExecutorSkippedRuns increases the count of triggers that did not run, by the executor.Executor and the reason
+	// for the skip.
+	IncExecutorSkippedRuns(id, reason string)
+	// ObserveRegisteredExecutors sets the number of executor.Executor(s) registered in the Selector.
+	ObserveRegisteredExecutors(n int)
+	// ObserveSelectLatency registers how long the Selector took to pick the executor.Executor(s) to run, excluding
+	// their execution.
+	ObserveSelectLatency(ctx context.Context, dur time.Duration)
 }
 
 type selector struct {
 	timeout time.Duration
-	exec    []executor.Executor
+	clock   Clock
+
+	// sem limits the number of concurrent executions, when set
+	sem chan struct{}
+	// waiting holds the occurrence that each gated executor.Executor is waiting for, to avoid waiting on it twice
+	waitMu  sync.Mutex
+	waiting map[string]time.Time
+	// sampler skips a ratio of the executions, when set
+	sampler *sampler
+	// collectErrors runs co-scheduled executors separately, joining the errors of the ones completing in time
+	collectErrors bool
+	// priorities orders the launch of co-scheduled executors by their priority, when set
+	priorities map[string]int
+
+	mu   sync.RWMutex
+	exec []executor.Executor
+
+	inflight  sync.WaitGroup
+	pendingMu sync.Mutex
+	pending   map[string]int
 
 	logger  *slog.Logger
 	metrics Metrics
@@ -77,8 +188,22 @@ type selector struct {
 // The Selector allows multiple executor.Executor to be configured, and multiple executor.Executor can share similar
 // execution times. If that is the case, the executor is launched in an executor.Multi call.
 //
+// Co-scheduled executors are launched (and their IDs returned by NextSelected) in the order of their priority, if
+// configured with WithPriorities, and then of their IDs. As they run concurrently, this order does not guarantee which
+// of them starts running first.
+//
 // The error returned from a Next call is the error raised by the executor.Executor's Exec call.
 func (s *selector) Next(ctx context.Context) error {
+	_, err := s.NextSelected(ctx)
+
+	return err
+}
+
+// NextSelected works like Next, while also returning the IDs of the executor.Executor(s) that it triggered.
+//
+// Since this Selector detaches from executions that exceed its timeout, the returned IDs include the
+// executor.Executor(s) that are still running when this call returns.
+func (s *selector) NextSelected(ctx context.Context) ([]string, error) {
 	ctx, span := s.tracer.Start(ctx, "Selector.Select")
 	defer span.End()
 
@@ -87,9 +212,12 @@ func (s *selector) Next(ctx context.Context) error {
 
 	// minStepDuration ensures that each execution is locked to the seconds mark and
 	// a runner is not executed more than once per trigger.
-	defer time.Sleep(minStepDuration)
+	defer sleep(ctx, minStepDuration)
+
+	start := time.Now()
+	execs := s.executors()
 
-	if len(s.exec) == 0 {
+	if len(execs) == 0 {
 		err := ErrEmptyExecutorsList
 
 		s.metrics.IncSelectorSelectCalls()
@@ -99,23 +227,73 @@ func (s *selector) Next(ctx context.Context) error {
 			slog.String("error", err.Error()),
 		)
 
-		return err
+		return nil, err
 	}
 
+	// a single disabled executor.Executor also goes through earliest, so that it waits for its scheduled time
+	if len(execs) > 1 || isDisabled(execs[0]) {
+		execs = byPriority(earliest(ctx, s.clock, execs), s.priorities)
+	}
+
+	s.metrics.ObserveSelectLatency(ctx, time.Since(start))
+
+	ids := executorIDs(execs)
+
 	localCtx, cancel := context.WithTimeout(ctx, s.timeout)
 	defer cancel()
 
+	var err error
+
+	if s.collectErrors && len(execs) > 1 {
+		err = s.execCollect(ctx, localCtx, execs)
+	} else {
+		err = s.execDetached(ctx, localCtx, execs)
+	}
+
+	if err == nil {
+		return ids, nil
+	}
+
+	if errors.Is(err, ErrExhaustedExecutorsList) {
+		span.AddEvent("no further occurrences")
+		s.logger.InfoContext(ctx, "no tasks left with further executions")
+
+		return nil, err
+	}
+
+	s.metrics.IncSelectorSelectCalls()
+	span.SetStatus(codes.Error, err.Error())
+	span.RecordError(err)
+	s.logger.ErrorContext(ctx, "failed to select and execute the next task",
+		slog.String("error", err.Error()),
+	)
+
+	return ids, err
+}
+
+// execDetached runs the input executor.Executor(s) in a goroutine, returning their error if they complete before the
+// input local context.Context is done. Otherwise, it detaches from the execution and returns nil.
+func (s *selector) execDetached(ctx, localCtx context.Context, execs []executor.Executor) error {
+	// wrapped before detaching, as the Selector's logger and metrics may be replaced while the execution is running
+	wrapped := s.gate(ctx, s.sampler.wrap(execs, s.logger, s.metrics))
+
+	if len(wrapped) == 0 && len(execs) > 0 {
+		// the occurrences are already waited for, by previous calls
+		<-localCtx.Done()
+
+		return nil
+	}
+
 	errCh := make(chan error)
 
+	s.inflight.Add(1)
+
 	go func() {
-		var err error
+		defer s.inflight.Done()
 
-		switch len(s.exec) {
-		case 1:
-			err = s.exec[0].Exec(ctx)
-		default:
-			err = executor.Multi(ctx, s.next(ctx)...)
-		}
+		s.track(wrapped, 1)
+		err := exec(ctx, wrapped)
+		s.track(wrapped, -1)
 
 		select {
 		case <-localCtx.Done():
@@ -135,52 +313,360 @@ func (s *selector) Next(ctx context.Context) error {
 			return nil
 		}
 
-		if err == nil {
-			return nil
+		return err
+	}
+}
+
+// execCollect runs each of the input executor.Executor(s) in its own goroutine, waiting for all of them until the input
+// local context.Context is done, and returns the joined errors of the ones that completed by then. Executions still
+// running are detached from.
+func (s *selector) execCollect(ctx, localCtx context.Context, execs []executor.Executor) error {
+	wrapped := s.gate(ctx, s.sampler.wrap(execs, s.logger, s.metrics))
+
+	// buffered, so that executions completing after the local context is done do not block
+	errCh := make(chan error, len(wrapped))
+
+	s.inflight.Add(len(wrapped))
+
+	for i := range wrapped {
+		go func(exec executor.Executor) {
+			defer s.inflight.Done()
+
+			s.track([]executor.Executor{exec}, 1)
+			err := exec.Exec(ctx)
+			s.track([]executor.Executor{exec}, -1)
+
+			errCh <- err
+		}(wrapped[i])
+	}
+
+	execErrs := make([]error, 0, len(wrapped))
+
+	for range wrapped {
+		select {
+		case <-localCtx.Done():
+			return errors.Join(execErrs...)
+		case err := <-errCh:
+			if err != nil {
+				execErrs = append(execErrs, err)
+			}
 		}
+	}
 
-		s.metrics.IncSelectorSelectCalls()
-		span.SetStatus(codes.Error, err.Error())
-		span.RecordError(err)
-		s.logger.ErrorContext(ctx, "failed to select and execute the next task",
-			slog.String("error", err.Error()),
-		)
+	return errors.Join(execErrs...)
+}
 
-		return err
+// gate wraps the input executor.Executor(s) so that their executions are limited by the Selector's semaphore, if
+// configured with a maximum concurrency.
+//
+// Executors whose next occurrence is already waited for by a previous call are left out, so that a Selector detaching
+// from long waits does not pile up executions waiting for the same occurrence.
+func (s *selector) gate(ctx context.Context, execs []executor.Executor) []executor.Executor {
+	if s.sem == nil {
+		return execs
+	}
+
+	s.waitMu.Lock()
+	defer s.waitMu.Unlock()
+
+	if s.waiting == nil {
+		s.waiting = make(map[string]time.Time, len(execs))
+	}
+
+	gated := make([]executor.Executor, 0, len(execs))
+
+	for i := range execs {
+		id := execs[i].ID()
+		at := execs[i].Next(ctx)
+
+		if waiting, ok := s.waiting[id]; ok && !at.IsZero() && waiting.Equal(at) {
+			continue
+		}
+
+		if !at.IsZero() {
+			s.waiting[id] = at
+		}
+
+		gated = append(gated, gatedExecutor{Executor: execs[i], sem: s.sem, done: func() { s.unwait(id, at) }})
+	}
+
+	return gated
+}
+
+// unwait clears the occurrence waited for by the executor.Executor with the input ID, if it is still the input time.
+func (s *selector) unwait(id string, at time.Time) {
+	s.waitMu.Lock()
+	defer s.waitMu.Unlock()
+
+	if waiting, ok := s.waiting[id]; ok && waiting.Equal(at) {
+		delete(s.waiting, id)
+	}
+}
+
+// gatedExecutor is an executor.Executor whose execution takes a slot in a semaphore once it is due, releasing it once
+// done (see executor.WithSlots). Waiting for a slot is interrupted if the input context.Context is done, which is
+// returned as an error.
+type gatedExecutor struct {
+	executor.Executor
+
+	sem  chan struct{}
+	done func()
+}
+
+// Exec runs the task when on its scheduled time, once a slot is available in the semaphore.
+func (e gatedExecutor) Exec(ctx context.Context) error {
+	if e.done != nil {
+		defer e.done()
+	}
+
+	return e.Executor.Exec(executor.WithSlots(ctx, e.sem))
+}
+
+// exec runs the input executor.Executor(s), returning ErrExhaustedExecutorsList if there are none to execute or if the
+// single executor.Executor has no further occurrences.
+func exec(ctx context.Context, execs []executor.Executor) error {
+	switch len(execs) {
+	case 0:
+		return ErrExhaustedExecutorsList
+	case 1:
+		if err := execs[0].Exec(ctx); err != nil {
+			if errors.Is(err, executor.ErrExhaustedScheduler) {
+				return ErrExhaustedExecutorsList
+			}
+
+			return err
+		}
+
+		return nil
+	default:
+		return executor.Multi(ctx, execs...)
+	}
+}
+
+// executorIDs returns the IDs of the input executor.Executor(s).
+func executorIDs(execs []executor.Executor) []string {
+	ids := make([]string, 0, len(execs))
+
+	for i := range execs {
+		ids = append(ids, execs[i].ID())
 	}
+
+	return ids
 }
 
-func (s *selector) next(ctx context.Context) []executor.Executor {
+// earliest returns the executor.Executor(s) with the nearest next execution time from the input Clock's current time,
+// out of the input set. Each executor.Executor's Next method is called exactly once.
+//
+// Disabled executor.Executor(s) are not due, so they are only returned if all executors with further occurrences are
+// disabled, wrapped so that they wait for their scheduled time instead of returning immediately.
+//
+// The returned executor.Executor(s) share the same next execution time, and are sorted by their ID, so that
+// co-scheduled executors are always launched in the same order regardless of the order they were added in.
+func earliest(ctx context.Context, clock Clock, execs []executor.Executor) []executor.Executor {
 	var (
-		next time.Duration
-		exec = make([]executor.Executor, 0, len(s.exec))
-		now  = time.Now()
+		next, nextDisabled time.Duration
+		exec, disabled     []executor.Executor
+		now                = now(clock)
 	)
 
-	for i := range s.exec {
-		t := s.exec[i].Next(ctx).Sub(now)
+	for i := range execs {
+		at := execs[i].Next(ctx)
 
-		switch {
-		case i == 0:
-			next = t
+		// skip executors without further occurrences
+		if at.IsZero() {
+			continue
+		}
 
-			exec = append(exec, s.exec[i])
+		if isDisabled(execs[i]) {
+			disabled, nextDisabled = nearest(disabled, nextDisabled, execs[i], at.Sub(now))
 
 			continue
-		case t == next:
-			exec = append(exec, s.exec[i])
+		}
 
+		exec, next = nearest(exec, next, execs[i], at.Sub(now))
+	}
+
+	if len(exec) == 0 {
+		exec = make([]executor.Executor, 0, len(disabled))
+
+		for i := range disabled {
+			exec = append(exec, disabledExecutor{Executor: disabled[i], clock: clock})
+		}
+	}
+
+	slices.SortStableFunc(exec, func(a, b executor.Executor) int {
+		return strings.Compare(a.ID(), b.ID())
+	})
+
+	return exec
+}
+
+// nearest adds the input executor.Executor to the input set if it is due at the same time as the set's executors (in
+// the input duration from now), or replaces the set with it if it is due earlier. It returns the resulting set and the
+// duration until its executors are due.
+func nearest(
+	execs []executor.Executor, next time.Duration, exec executor.Executor, t time.Duration,
+) ([]executor.Executor, time.Duration) {
+	switch {
+	case len(execs) == 0 || t < next:
+		return []executor.Executor{exec}, t
+	case t == next:
+		return append(execs, exec), next
+	default:
+		return execs, next
+	}
+}
+
+// Add includes the input executor.Executor(s) in the Selector's set of executors. Nil and no-op executors are
+// ignored.
+//
+// This call is safe to use concurrently with Next, where the changes are applied on the following Next call.
+func (s *selector) Add(execs ...executor.Executor) {
+	s.mu.Lock()
+	s.exec = addExecutors(s.exec, execs...)
+	s.metrics.ObserveRegisteredExecutors(len(s.exec))
+	s.mu.Unlock()
+}
+
+// Remove excludes any executor.Executor whose ID matches the input id from the Selector's set of executors.
+//
+// This call is safe to use concurrently with Next, where the changes are applied on the following Next call.
+func (s *selector) Remove(id string) {
+	s.mu.Lock()
+	s.exec = removeExecutor(s.exec, id)
+	s.metrics.ObserveRegisteredExecutors(len(s.exec))
+	s.mu.Unlock()
+}
+
+// Drain waits for any executions still in progress, launched by previous Next calls, to complete. It returns once they
+// are all done, or once the input context.Context is done -- returning the IDs of the executor.Executor(s) that are
+// still running, in this case.
+func (s *selector) Drain(ctx context.Context) []string {
+	done := make(chan struct{})
+
+	go func() {
+		s.inflight.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		s.pendingMu.Lock()
+		defer s.pendingMu.Unlock()
+
+		ids := make([]string, 0, len(s.pending))
+
+		for id := range s.pending {
+			ids = append(ids, id)
+		}
+
+		sort.Strings(ids)
+
+		return ids
+	}
+}
+
+func (s *selector) track(execs []executor.Executor, delta int) {
+	s.pendingMu.Lock()
+	defer s.pendingMu.Unlock()
+
+	if s.pending == nil {
+		s.pending = make(map[string]int, len(execs))
+	}
+
+	for i := range execs {
+		id := execs[i].ID()
+
+		s.pending[id] += delta
+
+		if s.pending[id] <= 0 {
+			delete(s.pending, id)
+		}
+	}
+}
+
+// Executors returns the Selector's current set of executor.Executor.
+//
+// The returned slice is a copy that the caller is free to modify. This call is safe to use concurrently with Next,
+// Add and Remove.
+func (s *selector) Executors() []executor.Executor {
+	return slices.Clone(s.executors())
+}
+
+// Schedule returns the ID and next execution time of each of the Selector's executor.Executor, sorted by their next
+// execution time. Executors without further occurrences are listed last, with a zero time.
+//
+// This call does not trigger any execution.
+func (s *selector) Schedule(ctx context.Context) []JobSchedule {
+	return schedule(ctx, s.executors())
+}
+
+func (s *selector) executors() []executor.Executor {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.exec
+}
+
+func schedule(ctx context.Context, execs []executor.Executor) []JobSchedule {
+	jobs := make([]JobSchedule, 0, len(execs))
+
+	for i := range execs {
+		jobs = append(jobs, JobSchedule{
+			ID:   execs[i].ID(),
+			Next: execs[i].Next(ctx),
+		})
+	}
+
+	slices.SortStableFunc(jobs, func(a, b JobSchedule) int {
+		switch {
+		case a.Next.IsZero() && b.Next.IsZero():
+			return 0
+		case a.Next.IsZero():
+			return 1
+		case b.Next.IsZero():
+			return -1
+		default:
+			return a.Next.Compare(b.Next)
+		}
+	})
+
+	return jobs
+}
+
+// addExecutors returns a new slice with the input executor.Executor(s) appended to the current ones, leaving the
+// current slice untouched for any caller still iterating through it.
+func addExecutors(cur []executor.Executor, execs ...executor.Executor) []executor.Executor {
+	updated := make([]executor.Executor, 0, len(cur)+len(execs))
+	updated = append(updated, cur...)
+
+	for i := range execs {
+		if execs[i] == nil || execs[i] == executor.NoOp() {
 			continue
-		case t < next:
-			next = t
-			exec = make([]executor.Executor, 0, len(s.exec))
-			exec = append(exec, s.exec[i])
+		}
+
+		updated = append(updated, execs[i])
+	}
 
+	return updated
+}
+
+// removeExecutor returns a new slice without the executor.Executor(s) matching the input ID, leaving the current slice
+// untouched for any caller still iterating through it.
+func removeExecutor(cur []executor.Executor, id string) []executor.Executor {
+	updated := make([]executor.Executor, 0, len(cur))
+
+	for i := range cur {
+		if cur[i].ID() == id {
 			continue
 		}
+
+		updated = append(updated, cur[i])
 	}
 
-	return exec
+	return updated
 }
 
 // New creates a Selector with the input cfg.Option(s), also returning an error if raised.
@@ -203,28 +689,68 @@ func newSelector(config *Config) (Selector, error) {
 		return noOpSelector{}, ErrEmptyExecutorsList
 	}
 
+	logger := slog.New(config.handler)
+	sampling := newSampler(config)
+
+	config.metrics.ObserveRegisteredExecutors(len(config.exec))
+
 	if config.block {
-		return &blockingSelector{
+		sel := &blockingSelector{
 			exec:    config.exec,
-			logger:  slog.New(config.handler),
+			sampler: sampling,
+			clock:   config.clock,
+			timeout: config.timeout,
+			logger:  logger,
 			metrics: config.metrics,
 			tracer:  config.tracer,
-		}, nil
+		}
+
+		if len(config.priorities) > 0 {
+			return &prioritySelector{
+				blockingSelector: sel,
+				priorities:       config.priorities,
+			}, nil
+		}
+
+		return sel, nil
 	}
 
 	if config.timeout < minStepDuration {
 		config.timeout = defaultTimeout
 	}
 
+	var sem chan struct{}
+
+	if config.maxConcurrency > 0 {
+		sem = make(chan struct{}, config.maxConcurrency)
+	}
+
 	return &selector{
-		timeout: config.timeout,
-		exec:    config.exec,
-		logger:  slog.New(config.handler),
-		metrics: config.metrics,
-		tracer:  config.tracer,
+		timeout:       config.timeout,
+		sem:           sem,
+		sampler:       sampling,
+		collectErrors: config.collectErrors,
+		priorities:    config.priorities,
+		clock:         config.clock,
+		exec:          config.exec,
+		logger:        logger,
+		metrics:       config.metrics,
+		tracer:        config.tracer,
 	}, nil
 }
 
+func newSampler(config *Config) *sampler {
+	if config.sampleRNG == nil {
+		return nil
+	}
+
+	return &sampler{
+		ratio: config.sampleRatio,
+		rng:   config.sampleRNG,
+		clock: config.clock,
+	}
+}
+
 // NoOp returns a no-op Selector.
 func NoOp() Selector {
 	return noOpSelector{}
@@ -239,3 +765,34 @@ type noOpSelector struct{}
 func (noOpSelector) Next(context.Context) error {
 	return nil
 }
+
+// NextSelected works like Next, while also returning the IDs of the executor.Executor(s) that it triggered.
+//
+// However, this is a no-op call, it has no effect and the returned IDs and error are always nil.
+func (noOpSelector) NextSelected(context.Context) ([]string, error) {
+	return nil, nil
+}
+
+// Add includes the input executor.Executor(s) in the Selector's set of executors.
+//
+// However, this is a no-op call, it has no effect.
+func (noOpSelector) Add(...executor.Executor) {}
+
+// Remove excludes any executor.Executor whose ID matches the input id from the Selector's set of executors.
+//
+// However, this is a no-op call, it has no effect.
+func (noOpSelector) Remove(string) {}
+
+// Executors returns the Selector's current set of executor.Executor.
+//
+// However, this is a no-op call, and the returned slice is always nil.
+func (noOpSelector) Executors() []executor.Executor {
+	return nil
+}
+
+// Schedule returns the ID and next execution time of each of the Selector's executor.Executor.
+//
+// However, this is a no-op call, and the returned slice is always nil.
+func (noOpSelector) Schedule(context.Context) []JobSchedule {
+	return nil
+}
@@ -2,6 +2,9 @@ package selector
 
 import (
 	"log/slog"
+	"maps"
+	"math"
+	"math/rand"
 	"time"
 
 	"github.com/zalgonoise/cfg"
@@ -17,6 +20,15 @@ type Config struct {
 	exec    []executor.Executor
 	block   bool
 	timeout time.Duration
+	clock   Clock
+
+	maxConcurrency int
+	collectErrors  bool
+
+	priorities map[string]int
+
+	sampleRatio float64
+	sampleRNG   *rand.Rand
 
 	handler slog.Handler
 	metrics Metrics
@@ -25,6 +37,7 @@ type Config struct {
 
 func defaultConfig() *Config {
 	return &Config{
+		clock:   realClock{},
 		handler: log.NoOp(),
 		metrics: metrics.NoOp(),
 		tracer:  noop.NewTracerProvider().Tracer("selector's no-op tracer"),
@@ -78,11 +91,114 @@ func WithBlock() cfg.Option[*Config] {
 	})
 }
 
+// WithMaxConcurrency configures a (non-blocking) Selector to run at most n executions at the same time. Any
+// executions beyond this limit wait for a running one to complete, or for their context.Context to be done.
+//
+// The limit applies once an execution is due, as waiting for its scheduled time does not take a slot; and each
+// occurrence is waited for once, even if the Selector detaches from it and selects it again. The executor.Executable
+// honors the limit out of the box, while custom executor.Executor(s) need to call executor.AcquireSlot before running
+// their task.
+//
+// A zero value keeps the executions unbounded, which is the default. This option has no effect on blocking Selectors,
+// as they run a single set of executions at a time.
+//
+// This call returns a cfg.NoOp cfg.Option if n is negative.
+func WithMaxConcurrency(n int) cfg.Option[*Config] {
+	if n < 0 {
+		return cfg.NoOp[*Config]{}
+	}
+
+	return cfg.Register(func(config *Config) *Config {
+		config.maxConcurrency = n
+
+		return config
+	})
+}
+
+// WithCollectErrors configures a (non-blocking) Selector to run co-scheduled executor.Executor(s) separately, waiting
+// for all of them up to its timeout, and returning the joined errors of the ones that complete by then.
+//
+// By default, co-scheduled executor.Executor(s) run as a single executor.Multi call, whose (joined) errors are only
+// returned if all of them complete before the timeout; otherwise the Selector detaches from them and returns nil, even
+// if some of them already failed. This option has no effect on blocking Selectors, as they always wait for all
+// executions and join their errors.
+func WithCollectErrors() cfg.Option[*Config] {
+	return cfg.Register(func(config *Config) *Config {
+		config.collectErrors = true
+
+		return config
+	})
+}
+
+// WithSampling configures the Selector to only run a ratio of the due executions, skipping the rest (e.g. to canary a
+// new job definition, running it on a fraction of its triggers). A ratio of 0.1 runs roughly one in ten executions.
+//
+// Each occurrence is sampled once and independently, from the input *rand.Rand, which allows deterministic sampling in
+// tests. A nil *rand.Rand is replaced with one seeded from the current time. Skipped executions still wait for their
+// scheduled time, and are logged and registered in the Selector's Metrics.
+//
+// This call returns a cfg.NoOp cfg.Option if the ratio is 1.0 or above (always run, which is the default), or if it is
+// negative or NaN.
+func WithSampling(ratio float64, rng *rand.Rand) cfg.Option[*Config] {
+	if math.IsNaN(ratio) || ratio < 0 || ratio >= 1 {
+		return cfg.NoOp[*Config]{}
+	}
+
+	if rng == nil {
+		//nolint:gosec // sampling is meant to spread executions, it does not require a cryptographically secure generator
+		rng = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+
+	return cfg.Register(func(config *Config) *Config {
+		config.sampleRatio = ratio
+		config.sampleRNG = rng
+
+		return config
+	})
+}
+
+// WithPriorities configures a Selector to order executor.Executor(s) due at the same time by their priority, from the
+// input map of executor.Executor IDs to their priority. Higher values take precedence, and executors missing from the
+// map have a priority of zero.
+//
+// Priorities only decide which executor.Executor(s) run with a blocking Selector (see WithBlock): it only executes the
+// ones with the highest priority, while the remaining ones skip that occurrence.
+//
+// A non-blocking Selector runs all of the executor.Executor(s) sharing the same next execution time concurrently, so
+// priorities do not guarantee that the higher priority ones run first (also when competing for slots with
+// WithMaxConcurrency). They only set the order that the executions are launched in, and that their IDs are returned
+// by the Selector's NextSelected method.
+//
+// This call returns a cfg.NoOp cfg.Option if the input map is empty.
+func WithPriorities(priorities map[string]int) cfg.Option[*Config] {
+	if len(priorities) == 0 {
+		return cfg.NoOp[*Config]{}
+	}
+
+	p := maps.Clone(priorities)
+
+	return cfg.Register(func(config *Config) *Config {
+		if config.priorities == nil {
+			config.priorities = p
+
+			return config
+		}
+
+		maps.Copy(config.priorities, p)
+
+		return config
+	})
+}
+
 // WithTimeout configures a (non-blocking) Selector to wait a certain duration before detaching of the executable task,
 // before continuing to select the next one.
 //
 // By default, the local context timeout is set to one second. Any negative or zero duration values result in a cfg.NoOp
 // cfg.Option being returned.
+//
+// When used with WithBlock, the timeout limits each execution to that duration past its scheduled time: its
+// context.Context is cancelled once it is exceeded, and the Selector's Next call returns context.DeadlineExceeded,
+// moving on to the next tick. Blocking Selectors have no timeout by default.
 func WithTimeout(dur time.Duration) cfg.Option[*Config] {
 	if dur <= 0 {
 		return cfg.NoOp[*Config]{}
@@ -108,6 +224,25 @@ func WithMetrics(m Metrics) cfg.Option[*Config] {
 	})
 }
 
+// WithClock configures the Clock used by the Selector to get the current time, when comparing the next execution time
+// of its executor.Executor(s) and waiting for it. The default Clock uses time.Now.
+//
+// The executor.Executor(s) resolve their own next execution time, so to simulate the passage of time (e.g. in tests)
+// the same Clock should be configured in them, with executor.WithClock.
+//
+// This call returns a cfg.NoOp cfg.Option if the input Clock is nil.
+func WithClock(clock Clock) cfg.Option[*Config] {
+	if clock == nil {
+		return cfg.NoOp[*Config]{}
+	}
+
+	return cfg.Register(func(config *Config) *Config {
+		config.clock = clock
+
+		return config
+	})
+}
+
 // WithLogger decorates the Selector with the input logger.
 func WithLogger(logger *slog.Logger) cfg.Option[*Config] {
 	if logger == nil {
@@ -5,6 +5,12 @@ import (
 	"errors"
 	"io"
 	"log/slog"
+	"math"
+	"math/rand"
+	"runtime"
+	"strconv"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -120,6 +126,55 @@ func TestConfig(t *testing.T) {
 				WithLogHandler(log.NoOp()),
 			},
 		},
+		{
+			name: "WithMaxConcurrency/Negative",
+			opts: []cfg.Option[*Config]{
+				WithMaxConcurrency(-1),
+			},
+		},
+		{
+			name: "WithMaxConcurrency/OK",
+			opts: []cfg.Option[*Config]{
+				WithMaxConcurrency(4),
+			},
+		},
+		{
+			name: "WithPriorities/Empty",
+			opts: []cfg.Option[*Config]{
+				WithPriorities(nil),
+			},
+		},
+		{
+			name: "WithPriorities/MultipleCalls",
+			opts: []cfg.Option[*Config]{
+				WithPriorities(map[string]int{"a": 1}),
+				WithPriorities(map[string]int{"b": 2}),
+			},
+		},
+		{
+			name: "WithSampling/OutOfRange",
+			opts: []cfg.Option[*Config]{
+				WithSampling(2, nil),
+			},
+		},
+		{
+			name: "WithSampling/OK",
+			opts: []cfg.Option[*Config]{
+				WithSampling(0.5, nil),
+			},
+		},
+		{
+			name: "WithClock/NilClock",
+			opts: []cfg.Option[*Config]{
+				WithClock(nil),
+			},
+		},
+		{
+			name: "WithClock/OK",
+			opts: []cfg.Option[*Config]{
+				WithClock(realClock{}),
+			},
+		},
 		{
 			name: "WithTrace/NilTracer",
 			opts: []cfg.Option[*Config]{
@@ -232,6 +287,14 @@ func TestSelectorWithLogs(t *testing.T) {
 type testSelector struct{}
 
 func (testSelector) Next(ctx context.Context) error { return ctx.Err() }
+func (testSelector) NextSelected(ctx context.Context) ([]string, error) {
+	return nil, ctx.Err()
+}
+func (testSelector) Add(...executor.Executor)       {}
+func (testSelector) Remove(string)                  {}
+func (testSelector) Executors() []executor.Executor { return nil }
+
+func (testSelector) Schedule(context.Context) []JobSchedule { return nil }
 
 func TestSelectorWithMetrics(t *testing.T) {
 	m := metrics.NoOp()
@@ -498,3 +561,1258 @@ func TestZeroExecutors(t *testing.T) {
 		is.True(t, errors.Is(ErrEmptyExecutorsList, err))
 	})
 }
+
+type exhaustedExecutor struct{}
+
+func (exhaustedExecutor) Exec(context.Context) error     { return executor.ErrExhaustedScheduler }
+func (exhaustedExecutor) RunNow(context.Context) error   { return nil }
+func (exhaustedExecutor) Next(context.Context) time.Time { return time.Time{} }
+func (exhaustedExecutor) ID() string                     { return "exhausted" }
+
+func TestExhaustedExecutors(t *testing.T) {
+	for _, testcase := range []struct {
+		name  string
+		execs []executor.Executor
+	}{
+		{
+			name:  "SingleExecutor",
+			execs: []executor.Executor{exhaustedExecutor{}},
+		},
+		{
+			name:  "MultipleExecutors",
+			execs: []executor.Executor{exhaustedExecutor{}, exhaustedExecutor{}},
+		},
+	} {
+		t.Run(testcase.name+"/WithBlock", func(t *testing.T) {
+			sel, err := New(WithExecutors(testcase.execs...), WithBlock())
+			is.Empty(t, err)
+
+			is.True(t, errors.Is(sel.Next(context.Background()), ErrExhaustedExecutorsList))
+		})
+
+		t.Run(testcase.name+"/NonBlocking", func(t *testing.T) {
+			sel, err := New(WithExecutors(testcase.execs...))
+			is.Empty(t, err)
+
+			is.True(t, errors.Is(sel.Next(context.Background()), ErrExhaustedExecutorsList))
+		})
+	}
+}
+
+type countingExecutor struct {
+	id    string
+	at    time.Time
+	calls *int
+}
+
+func (e countingExecutor) Exec(context.Context) error   { return nil }
+func (e countingExecutor) RunNow(context.Context) error { return nil }
+func (e countingExecutor) ID() string                   { return e.id }
+func (e countingExecutor) Next(context.Context) time.Time {
+	*e.calls++
+
+	return e.at
+}
+
+type fixedClock struct {
+	now time.Time
+}
+
+func (c fixedClock) Now() time.Time { return c.now }
+
+func TestNextCallsExecutorsOnce(t *testing.T) {
+	now := time.Now()
+	calls := make([]int, 5)
+	execs := []executor.Executor{
+		countingExecutor{id: "0", at: now.Add(3 * time.Second), calls: &calls[0]},
+		countingExecutor{id: "1", at: now.Add(time.Second), calls: &calls[1]},
+		countingExecutor{id: "2", at: time.Time{}, calls: &calls[2]},
+		countingExecutor{id: "3", at: now.Add(time.Second), calls: &calls[3]},
+		countingExecutor{id: "4", at: now.Add(2 * time.Second), calls: &calls[4]},
+	}
+
+	selected := earliest(context.Background(), fixedClock{now: now}, execs)
+
+	is.Equal(t, 2, len(selected))
+	is.Equal(t, "1", selected[0].ID())
+	is.Equal(t, "3", selected[1].ID())
+
+	for i := range calls {
+		is.Equal(t, 1, calls[i])
+	}
+}
+
+func TestAddRemove(t *testing.T) {
+	newExec := func(id string) executor.Executor {
+		calls := 0
+
+		return countingExecutor{id: id, at: time.Now().Add(time.Hour), calls: &calls}
+	}
+
+	for _, testcase := range []struct {
+		name string
+		opts []cfg.Option[*Config]
+	}{
+		{
+			name: "WithBlock",
+			opts: []cfg.Option[*Config]{WithBlock()},
+		},
+		{
+			name: "NonBlocking",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			sel, err := New(append(testcase.opts, WithExecutors(newExec("a")))...)
+			is.Empty(t, err)
+
+			executors := func() []executor.Executor {
+				switch s := sel.(type) {
+				case *selector:
+					return s.executors()
+				case *blockingSelector:
+					return s.executors()
+				default:
+					return nil
+				}
+			}
+
+			snapshot := executors()
+
+			sel.Add(newExec("b"), nil, executor.NoOp(), newExec("c"))
+			is.Equal(t, 3, len(executors()))
+
+			sel.Remove("b")
+			sel.Remove("unknown")
+
+			current := executors()
+			is.Equal(t, 2, len(current))
+			is.Equal(t, "a", current[0].ID())
+			is.Equal(t, "c", current[1].ID())
+
+			// snapshots taken before a mutation are left untouched
+			is.Equal(t, 1, len(snapshot))
+			is.Equal(t, "a", snapshot[0].ID())
+
+			sel.Remove("a")
+			sel.Remove("c")
+			is.True(t, errors.Is(sel.Next(context.Background()), ErrEmptyExecutorsList))
+		})
+	}
+}
+
+func TestAddRemoveConcurrently(t *testing.T) {
+	const rounds = 20
+
+	sel, err := New(WithBlock(), WithExecutors(exhaustedExecutor{}))
+	is.Empty(t, err)
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		for i := 0; i < rounds; i++ {
+			sel.Add(exhaustedExecutor{})
+			sel.Remove("exhausted")
+		}
+	}()
+
+	for i := 0; i < rounds; i++ {
+		err := sel.Next(context.Background())
+		is.True(t, errors.Is(err, ErrExhaustedExecutorsList) || errors.Is(err, ErrEmptyExecutorsList))
+	}
+
+	<-done
+}
+
+func TestSchedule(t *testing.T) {
+	now := time.Now()
+	calls := make([]int, 3)
+	execs := []executor.Executor{
+		countingExecutor{id: "done", at: time.Time{}, calls: &calls[0]},
+		countingExecutor{id: "later", at: now.Add(time.Hour), calls: &calls[1]},
+		countingExecutor{id: "sooner", at: now.Add(time.Minute), calls: &calls[2]},
+	}
+
+	for _, testcase := range []struct {
+		name string
+		opts []cfg.Option[*Config]
+	}{
+		{
+			name: "WithBlock",
+			opts: []cfg.Option[*Config]{WithBlock()},
+		},
+		{
+			name: "WithPriorities",
+			opts: []cfg.Option[*Config]{WithPriorities(map[string]int{"later": 1})},
+		},
+		{
+			name: "WithBlock/WithPriorities",
+			opts: []cfg.Option[*Config]{WithBlock(), WithPriorities(map[string]int{"later": 1})},
+		},
+		{
+			name: "NonBlocking",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			sel, err := New(append(testcase.opts, WithExecutors(execs...))...)
+			is.Empty(t, err)
+
+			jobs := sel.Schedule(context.Background())
+
+			is.Equal(t, 3, len(jobs))
+			is.Equal(t, JobSchedule{ID: "sooner", Next: now.Add(time.Minute)}, jobs[0])
+			is.Equal(t, JobSchedule{ID: "later", Next: now.Add(time.Hour)}, jobs[1])
+			is.Equal(t, JobSchedule{ID: "done"}, jobs[2])
+
+			listed := sel.Executors()
+			is.Equal(t, 3, len(listed))
+
+			// the returned slice is a copy of the Selector's executors
+			listed[0] = nil
+			is.Equal(t, "done", sel.Executors()[0].ID())
+
+			// changes to the Selector are not reflected in a previously returned slice
+			sel.Remove("done")
+			is.Equal(t, 2, len(sel.Executors()))
+			is.Equal(t, 3, len(listed))
+		})
+	}
+}
+
+func TestNextSelected(t *testing.T) {
+	for _, testcase := range []struct {
+		name string
+		opts []cfg.Option[*Config]
+	}{
+		{
+			name: "WithBlock",
+			opts: []cfg.Option[*Config]{WithBlock()},
+		},
+		{
+			name: "NonBlocking",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			now := time.Now()
+			calls := make([]int, 4)
+			execs := []executor.Executor{
+				countingExecutor{id: "later", at: now.Add(time.Hour), calls: &calls[0]},
+				countingExecutor{id: "first", at: now.Add(time.Second), calls: &calls[1]},
+				countingExecutor{id: "done", at: time.Time{}, calls: &calls[2]},
+				countingExecutor{id: "second", at: now.Add(time.Second), calls: &calls[3]},
+			}
+
+			sel, err := New(append(testcase.opts, WithExecutors(execs...))...)
+			is.Empty(t, err)
+
+			ids, err := sel.NextSelected(context.Background())
+			is.Empty(t, err)
+			is.EqualElements(t, []string{"first", "second"}, ids)
+
+			// executors without further occurrences are not triggered
+			sel, err = New(append(testcase.opts, WithExecutors(execs[2], execs[2]))...)
+			is.Empty(t, err)
+
+			ids, err = sel.NextSelected(context.Background())
+			is.True(t, errors.Is(err, ErrExhaustedExecutorsList))
+			is.Equal(t, 0, len(ids))
+		})
+	}
+
+	ids, err := NoOp().NextSelected(context.Background())
+	is.Empty(t, err)
+	is.Equal(t, 0, len(ids))
+}
+
+func TestNextSelected_TieBreak(t *testing.T) {
+	for _, testcase := range []struct {
+		name string
+		opts []cfg.Option[*Config]
+	}{
+		{
+			name: "WithBlock",
+			opts: []cfg.Option[*Config]{WithBlock()},
+		},
+		{
+			name: "WithPriorities",
+			opts: []cfg.Option[*Config]{WithPriorities(map[string]int{"later": 10})},
+		},
+		{
+			name: "WithBlock/WithPriorities",
+			opts: []cfg.Option[*Config]{WithBlock(), WithPriorities(map[string]int{"later": 10})},
+		},
+		{
+			name: "NonBlocking",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			now := time.Now()
+			calls := make([]int, 4)
+			execs := []executor.Executor{
+				countingExecutor{id: "charlie", at: now.Add(time.Second), calls: &calls[0]},
+				countingExecutor{id: "alpha", at: now.Add(time.Second), calls: &calls[1]},
+				countingExecutor{id: "later", at: now.Add(time.Hour), calls: &calls[2]},
+				countingExecutor{id: "bravo", at: now.Add(time.Second), calls: &calls[3]},
+			}
+
+			// co-scheduled executors are ordered by their ID, regardless of the order they were added in
+			for _, order := range [][]int{{0, 1, 2, 3}, {3, 2, 1, 0}, {1, 3, 0, 2}} {
+				sel, err := New(append(testcase.opts, WithExecutors(
+					execs[order[0]], execs[order[1]], execs[order[2]], execs[order[3]],
+				))...)
+				is.Empty(t, err)
+
+				ids, err := sel.NextSelected(context.Background())
+				is.Empty(t, err)
+				is.EqualElements(t, []string{"alpha", "bravo", "charlie"}, ids)
+			}
+		})
+	}
+}
+
+func TestWithClock(t *testing.T) {
+	day := time.Date(2023, 10, 30, 0, 0, 0, 0, time.UTC)
+
+	for _, testcase := range []struct {
+		name  string
+		opts  []cfg.Option[*Config]
+		now   time.Time
+		wants string
+		at    time.Time
+	}{
+		{
+			name:  "NonBlocking/Morning",
+			now:   day.Add(10*time.Hour - 100*time.Millisecond),
+			wants: "morning",
+			at:    day.Add(10 * time.Hour),
+		},
+		{
+			name:  "NonBlocking/Evening",
+			now:   day.Add(18*time.Hour - 100*time.Millisecond),
+			wants: "evening",
+			at:    day.Add(18 * time.Hour),
+		},
+		{
+			name:  "WithBlock/Morning",
+			opts:  []cfg.Option[*Config]{WithBlock(), WithTimeout(time.Second)},
+			now:   day.Add(10*time.Hour - 100*time.Millisecond),
+			wants: "morning",
+			at:    day.Add(10 * time.Hour),
+		},
+		{
+			name:  "WithBlock/Evening",
+			opts:  []cfg.Option[*Config]{WithBlock(), WithTimeout(time.Second)},
+			now:   day.Add(18*time.Hour - 100*time.Millisecond),
+			wants: "evening",
+			at:    day.Add(18 * time.Hour),
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			// the clock is far from the real time, and decides both which executor is due and when it fires
+			clock := fixedClock{now: testcase.now}
+			scheduled := make(chan time.Time, 2)
+
+			newExec := func(id, cron string) executor.Executor {
+				exec, err := executor.New(id,
+					executor.WithSchedule(cron),
+					executor.WithLocation(time.UTC),
+					executor.WithClock(clock),
+					executor.WithRunners(executor.Runnable(func(ctx context.Context) error {
+						at, _ := executor.ScheduledTime(ctx)
+						scheduled <- at
+
+						return nil
+					})),
+				)
+				is.Empty(t, err)
+
+				return exec
+			}
+
+			sel, err := New(append(testcase.opts,
+				WithClock(clock),
+				WithExecutors(
+					newExec("morning", "0 0 10 * * *"),
+					newExec("evening", "0 0 18 * * *"),
+				),
+			)...)
+			is.Empty(t, err)
+
+			start := time.Now()
+
+			ids, err := sel.NextSelected(context.Background())
+			is.Empty(t, err)
+			is.EqualElements(t, []string{testcase.wants}, ids)
+			is.Equal(t, testcase.at, <-scheduled)
+
+			// the executor waits for the duration between the clock's time and its scheduled time, in real time
+			is.True(t, time.Since(start) < time.Second)
+		})
+	}
+}
+
+type priorityMetrics struct {
+	Metrics
+
+	mu      *sync.Mutex
+	skipped *[]string
+}
+
+func (m priorityMetrics) IncExecutorSkippedRuns(id, reason string) {
+	if reason != executor.SkipReasonPriority {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	*m.skipped = append(*m.skipped, id)
+}
+
+func TestPrioritySelector(t *testing.T) {
+	now := time.Now()
+	calls := make([]int, 4)
+
+	for _, testcase := range []struct {
+		name         string
+		execs        []executor.Executor
+		priorities   map[string]int
+		wantsBlock   []string
+		wantsSkipped []string
+		wantsAll     []string
+	}{
+		{
+			name: "HighestPriorityWins",
+			execs: []executor.Executor{
+				countingExecutor{id: "low", at: now.Add(time.Second), calls: &calls[0]},
+				countingExecutor{id: "high", at: now.Add(time.Second), calls: &calls[1]},
+				countingExecutor{id: "mid", at: now.Add(time.Second), calls: &calls[2]},
+			},
+			priorities:   map[string]int{"low": 1, "mid": 5, "high": 10},
+			wantsBlock:   []string{"high"},
+			wantsSkipped: []string{"mid", "low"},
+			wantsAll:     []string{"high", "mid", "low"},
+		},
+		{
+			name: "TiedPrioritiesShareTheSlot",
+			execs: []executor.Executor{
+				countingExecutor{id: "a", at: now.Add(time.Second), calls: &calls[0]},
+				countingExecutor{id: "b", at: now.Add(time.Second), calls: &calls[1]},
+				countingExecutor{id: "c", at: now.Add(time.Second), calls: &calls[2]},
+			},
+			priorities:   map[string]int{"a": 3, "c": 3},
+			wantsBlock:   []string{"a", "c"},
+			wantsSkipped: []string{"b"},
+			wantsAll:     []string{"a", "c", "b"},
+		},
+		{
+			name: "MissingPrioritiesDefaultToZero",
+			execs: []executor.Executor{
+				countingExecutor{id: "unset", at: now.Add(time.Second), calls: &calls[0]},
+				countingExecutor{id: "negative", at: now.Add(time.Second), calls: &calls[1]},
+			},
+			priorities:   map[string]int{"negative": -1},
+			wantsBlock:   []string{"unset"},
+			wantsSkipped: []string{"negative"},
+			wantsAll:     []string{"unset", "negative"},
+		},
+		{
+			name: "EarliestBeforePriority",
+			execs: []executor.Executor{
+				countingExecutor{id: "later", at: now.Add(time.Minute), calls: &calls[0]},
+				countingExecutor{id: "sooner", at: now.Add(time.Second), calls: &calls[1]},
+			},
+			priorities: map[string]int{"later": 10},
+			wantsBlock: []string{"sooner"},
+			wantsAll:   []string{"sooner"},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Run("WithBlock", func(t *testing.T) {
+				skipped := make([]string, 0, len(testcase.execs))
+
+				sel, err := New(
+					WithBlock(),
+					WithExecutors(testcase.execs...),
+					WithPriorities(testcase.priorities),
+					WithMetrics(priorityMetrics{Metrics: metrics.NoOp(), mu: &sync.Mutex{}, skipped: &skipped}),
+				)
+				is.Empty(t, err)
+
+				_, ok := sel.(*prioritySelector)
+				is.True(t, ok)
+
+				// decorating the Selector keeps its priorities
+				sel = AddLogs(sel, slog.NewTextHandler(io.Discard, nil))
+
+				ids, err := sel.NextSelected(context.Background())
+				is.Empty(t, err)
+				is.EqualElements(t, testcase.wantsBlock, ids)
+
+				// outranked executors are registered as skipped runs
+				is.EqualElements(t, testcase.wantsSkipped, skipped)
+			})
+
+			t.Run("NonBlocking", func(t *testing.T) {
+				skipped := make([]string, 0, len(testcase.execs))
+
+				sel, err := New(
+					WithExecutors(testcase.execs...),
+					WithPriorities(testcase.priorities),
+					WithMetrics(priorityMetrics{Metrics: metrics.NoOp(), mu: &sync.Mutex{}, skipped: &skipped}),
+				)
+				is.Empty(t, err)
+
+				// priorities do not imply blocking
+				_, ok := sel.(*selector)
+				is.True(t, ok)
+
+				// all due executors run, launched (and returned) in priority order
+				ids, err := sel.NextSelected(context.Background())
+				is.Empty(t, err)
+				is.EqualElements(t, testcase.wantsAll, ids)
+				is.Equal(t, 0, len(skipped))
+			})
+		})
+	}
+}
+
+type concurrentExecutor struct {
+	id      string
+	at      time.Time
+	running *atomic.Int32
+	peak    *atomic.Int32
+	done    *atomic.Int32
+}
+
+func (e concurrentExecutor) ID() string                     { return e.id }
+func (e concurrentExecutor) Next(context.Context) time.Time { return e.at }
+func (e concurrentExecutor) RunNow(context.Context) error   { return nil }
+func (e concurrentExecutor) Exec(ctx context.Context) error {
+	release, err := executor.AcquireSlot(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer release()
+
+	n := e.running.Add(1)
+	defer e.running.Add(-1)
+
+	for {
+		peak := e.peak.Load()
+		if n <= peak || e.peak.CompareAndSwap(peak, n) {
+			break
+		}
+	}
+
+	time.Sleep(30 * time.Millisecond)
+	e.done.Add(1)
+
+	return nil
+}
+
+func TestWithMaxConcurrency(t *testing.T) {
+	for _, testcase := range []struct {
+		name           string
+		maxConcurrency int
+		maxPeak        int32
+	}{
+		{
+			name:           "Bounded",
+			maxConcurrency: 2,
+			maxPeak:        2,
+		},
+		{
+			name:    "Unbounded",
+			maxPeak: 6,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var (
+				running, peak, done atomic.Int32
+				at                  = time.Now().Add(time.Hour)
+				execs               = make([]executor.Executor, 0, 6)
+			)
+
+			for i := 0; i < 6; i++ {
+				execs = append(execs, concurrentExecutor{
+					id: strconv.Itoa(i), at: at, running: &running, peak: &peak, done: &done,
+				})
+			}
+
+			sel, err := New(
+				WithExecutors(execs...),
+				WithMaxConcurrency(testcase.maxConcurrency),
+				WithTimeout(time.Second),
+			)
+			is.Empty(t, err)
+
+			ids, err := sel.NextSelected(context.Background())
+			is.Empty(t, err)
+			is.Equal(t, 6, len(ids))
+			is.Equal(t, int32(6), done.Load())
+			is.True(t, peak.Load() <= testcase.maxPeak)
+			is.True(t, peak.Load() > 1)
+		})
+	}
+}
+
+func TestWithMaxConcurrency_Goroutines(t *testing.T) {
+	var runs atomic.Int32
+
+	// the next occurrence is months away, at best
+	exec, err := executor.New("far",
+		executor.WithSchedule("0 0 1 1 *"),
+		executor.WithRunners(executor.Runnable(func(context.Context) error {
+			runs.Add(1)
+
+			return nil
+		})),
+	)
+	is.Empty(t, err)
+
+	sel, err := New(
+		WithExecutors(exec),
+		WithMaxConcurrency(1),
+		WithTimeout(minStepDuration),
+	)
+	is.Empty(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	is.Empty(t, sel.Next(ctx))
+
+	// the Selector detaches from the first wait, which neither takes the slot nor is launched again on each poll
+	goroutines := runtime.NumGoroutine()
+
+	for i := 0; i < 20; i++ {
+		is.Empty(t, sel.Next(ctx))
+	}
+
+	is.True(t, runtime.NumGoroutine() <= goroutines)
+	is.Equal(t, int32(0), runs.Load())
+}
+
+func TestGatedExecutor_Cancelled(t *testing.T) {
+	sem := make(chan struct{}, 1)
+	sem <- struct{}{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var running, peak, done atomic.Int32
+
+	exec := gatedExecutor{
+		Executor: concurrentExecutor{id: "waiting", running: &running, peak: &peak, done: &done},
+		sem:      sem,
+	}
+
+	is.True(t, errors.Is(exec.Exec(ctx), context.Canceled))
+	is.Equal(t, 1, len(sem))
+	is.Equal(t, int32(0), done.Load())
+}
+
+type sampledMetrics struct {
+	Metrics
+
+	skips *atomic.Int32
+}
+
+func (m sampledMetrics) IncExecutorSkippedRuns(_, reason string) {
+	if reason == executor.SkipReasonSampling {
+		m.skips.Add(1)
+	}
+}
+
+// execCountingExecutor is due on every call, with a new occurrence each time.
+type execCountingExecutor struct {
+	id    string
+	execs *atomic.Int32
+}
+
+func (e execCountingExecutor) ID() string                     { return e.id }
+func (e execCountingExecutor) Next(context.Context) time.Time { return time.Now() }
+func (e execCountingExecutor) RunNow(context.Context) error   { return nil }
+func (e execCountingExecutor) Exec(context.Context) error {
+	e.execs.Add(1)
+
+	return nil
+}
+
+// countingSource is a rand.Source that counts the values it generates.
+type countingSource struct {
+	rand.Source
+
+	calls atomic.Int32
+}
+
+func (s *countingSource) Int63() int64 {
+	s.calls.Add(1)
+
+	return s.Source.Int63()
+}
+
+// occurrenceExecutor has a single occurrence, running its task at most once.
+type occurrenceExecutor struct {
+	id      string
+	at      time.Time
+	claimed *atomic.Bool
+	execs   *atomic.Int32
+}
+
+func (e occurrenceExecutor) ID() string                   { return e.id }
+func (e occurrenceExecutor) RunNow(context.Context) error { return nil }
+func (e occurrenceExecutor) Next(context.Context) time.Time {
+	if !time.Now().Before(e.at) {
+		return time.Time{}
+	}
+
+	return e.at
+}
+
+func (e occurrenceExecutor) Exec(ctx context.Context) error {
+	if e.Next(ctx).IsZero() {
+		return executor.ErrExhaustedScheduler
+	}
+
+	timer := time.NewTimer(time.Until(e.at))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+	}
+
+	if e.claimed.CompareAndSwap(false, true) {
+		e.execs.Add(1)
+	}
+
+	return nil
+}
+
+func TestWithSampling(t *testing.T) {
+	const (
+		numCalls = 20
+		seed     = 42
+	)
+
+	expectedRuns := func(ratio float64) int32 {
+		rng := rand.New(rand.NewSource(seed))
+
+		var runs int32
+
+		for i := 0; i < numCalls; i++ {
+			if rng.Float64() < ratio {
+				runs++
+			}
+		}
+
+		return runs
+	}
+
+	for _, testcase := range []struct {
+		name  string
+		ratio float64
+		opts  []cfg.Option[*Config]
+	}{
+		{
+			name:  "NeverRun",
+			ratio: 0,
+		},
+		{
+			name:  "HalfTheRuns",
+			ratio: 0.5,
+		},
+		{
+			name:  "Blocking/HalfTheRuns",
+			ratio: 0.5,
+			opts:  []cfg.Option[*Config]{WithBlock()},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var execs, skips atomic.Int32
+
+			sel, err := New(append(testcase.opts,
+				WithExecutors(execCountingExecutor{id: "canary", execs: &execs}),
+				WithSampling(testcase.ratio, rand.New(rand.NewSource(seed))),
+				WithMetrics(sampledMetrics{Metrics: metrics.NoOp(), skips: &skips}),
+				WithTimeout(time.Second),
+			)...)
+			is.Empty(t, err)
+
+			for i := 0; i < numCalls; i++ {
+				is.Empty(t, sel.Next(context.Background()))
+			}
+
+			runs := expectedRuns(testcase.ratio)
+
+			is.Equal(t, runs, execs.Load())
+			is.Equal(t, numCalls-runs, skips.Load())
+		})
+	}
+
+	t.Run("AddMetrics", func(t *testing.T) {
+		var execs, skips atomic.Int32
+
+		sel, err := New(
+			WithExecutors(execCountingExecutor{id: "canary", execs: &execs}),
+			WithSampling(0, rand.New(rand.NewSource(seed))),
+		)
+		is.Empty(t, err)
+
+		sel = AddMetrics(sel, sampledMetrics{Metrics: metrics.NoOp(), skips: &skips})
+
+		is.Empty(t, sel.Next(context.Background()))
+		is.Equal(t, int32(0), execs.Load())
+		is.Equal(t, int32(1), skips.Load())
+	})
+
+	t.Run("OncePerOccurrence", func(t *testing.T) {
+		var (
+			execs, skips atomic.Int32
+			claimed      atomic.Bool
+			src          = &countingSource{Source: rand.NewSource(seed)}
+		)
+
+		// the occurrence is several timeouts away, so the non-blocking Selector detaches from it and selects it again
+		sel, err := New(
+			WithExecutors(occurrenceExecutor{
+				id:      "canary",
+				at:      time.Now().Add(500 * time.Millisecond),
+				claimed: &claimed,
+				execs:   &execs,
+			}),
+			WithSampling(0.5, rand.New(src)),
+			WithMetrics(sampledMetrics{Metrics: metrics.NoOp(), skips: &skips}),
+			WithTimeout(minStepDuration),
+		)
+		is.Empty(t, err)
+
+		var polls int
+
+		for ; polls < 50; polls++ {
+			if err := sel.Next(context.Background()); err != nil {
+				is.True(t, errors.Is(err, ErrExhaustedExecutorsList))
+
+				break
+			}
+		}
+
+		// wait for the detached executions to register the occurrence
+		nonBlocking, ok := sel.(*selector)
+		is.True(t, ok)
+		nonBlocking.inflight.Wait()
+
+		is.True(t, polls > 1)
+		is.Equal(t, int32(1), src.calls.Load())
+		is.Equal(t, int32(1), execs.Load()+skips.Load())
+	})
+
+	t.Run("AlwaysRunIsNoOp", func(t *testing.T) {
+		is.Equal(t, cfg.Option[*Config](cfg.NoOp[*Config]{}), WithSampling(1, nil))
+		is.Equal(t, cfg.Option[*Config](cfg.NoOp[*Config]{}), WithSampling(-0.1, nil))
+		is.Equal(t, cfg.Option[*Config](cfg.NoOp[*Config]{}), WithSampling(math.NaN(), nil))
+	})
+
+	t.Run("NilRandIsSeeded", func(t *testing.T) {
+		config := cfg.Set(new(Config), WithSampling(0.5, nil))
+
+		is.Equal(t, 0.5, config.sampleRatio)
+		is.True(t, config.sampleRNG != nil)
+	})
+}
+
+func TestNextStepDuration(t *testing.T) {
+	for _, testcase := range []struct {
+		name string
+		opts []cfg.Option[*Config]
+	}{
+		{
+			name: "NonBlocking",
+		},
+		{
+			name: "WithBlock",
+			opts: []cfg.Option[*Config]{WithBlock()},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var execs atomic.Int32
+
+			sel, err := New(append(testcase.opts,
+				WithExecutors(execCountingExecutor{id: "instant", execs: &execs}),
+			)...)
+			is.Empty(t, err)
+
+			t.Run("Waits", func(t *testing.T) {
+				start := time.Now()
+				_ = sel.Next(context.Background())
+
+				is.True(t, time.Since(start) >= minStepDuration)
+			})
+
+			t.Run("Cancelled", func(t *testing.T) {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				start := time.Now()
+				_ = sel.Next(ctx)
+
+				is.True(t, time.Since(start) < minStepDuration)
+			})
+		})
+	}
+}
+
+type executorsGauge struct {
+	Metrics
+
+	n *atomic.Int32
+}
+
+func (m executorsGauge) ObserveRegisteredExecutors(n int) { m.n.Store(int32(n)) }
+
+func TestObserveRegisteredExecutors(t *testing.T) {
+	newExec := func(id string) executor.Executor {
+		calls := 0
+
+		return countingExecutor{id: id, at: time.Now().Add(time.Hour), calls: &calls}
+	}
+
+	for _, testcase := range []struct {
+		name string
+		opts []cfg.Option[*Config]
+	}{
+		{
+			name: "NonBlocking",
+		},
+		{
+			name: "WithBlock",
+			opts: []cfg.Option[*Config]{WithBlock()},
+		},
+		{
+			name: "WithPriorities",
+			opts: []cfg.Option[*Config]{WithBlock(), WithPriorities(map[string]int{"a": 1})},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var n atomic.Int32
+
+			sel, err := New(append(testcase.opts,
+				WithExecutors(newExec("a"), newExec("b")),
+				WithMetrics(executorsGauge{Metrics: metrics.NoOp(), n: &n}),
+			)...)
+			is.Empty(t, err)
+			is.Equal(t, int32(2), n.Load())
+
+			sel.Add(newExec("c"))
+			is.Equal(t, int32(3), n.Load())
+
+			sel.Remove("a")
+			is.Equal(t, int32(2), n.Load())
+
+			var replaced atomic.Int32
+
+			_ = AddMetrics(sel, executorsGauge{Metrics: metrics.NoOp(), n: &replaced})
+			is.Equal(t, int32(2), replaced.Load())
+		})
+	}
+}
+
+type selectLatencies struct {
+	Metrics
+
+	mu        *sync.Mutex
+	latencies *[]time.Duration
+}
+
+func (m selectLatencies) ObserveSelectLatency(_ context.Context, dur time.Duration) {
+	m.mu.Lock()
+	*m.latencies = append(*m.latencies, dur)
+	m.mu.Unlock()
+}
+
+func TestObserveSelectLatency(t *testing.T) {
+	const delay = 100 * time.Millisecond
+
+	for _, testcase := range []struct {
+		name  string
+		opts  []cfg.Option[*Config]
+		execs []executor.Executor
+	}{
+		{
+			name:  "NonBlocking/Single",
+			execs: []executor.Executor{delayedExecutor{id: "a", at: time.Now(), delay: delay}},
+		},
+		{
+			name: "NonBlocking/Multiple",
+			execs: []executor.Executor{
+				delayedExecutor{id: "a", at: time.Now(), delay: delay},
+				delayedExecutor{id: "b", at: time.Now().Add(time.Hour)},
+			},
+		},
+		{
+			name:  "WithBlock/Single",
+			opts:  []cfg.Option[*Config]{WithBlock()},
+			execs: []executor.Executor{delayedExecutor{id: "a", at: time.Now(), delay: delay}},
+		},
+		{
+			name: "WithBlock/Multiple",
+			opts: []cfg.Option[*Config]{WithBlock()},
+			execs: []executor.Executor{
+				delayedExecutor{id: "a", at: time.Now(), delay: delay},
+				delayedExecutor{id: "b", at: time.Now().Add(time.Hour)},
+			},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var (
+				mu        sync.Mutex
+				latencies []time.Duration
+			)
+
+			sel, err := New(append(testcase.opts,
+				WithExecutors(testcase.execs...),
+				WithMetrics(selectLatencies{Metrics: metrics.NoOp(), mu: &mu, latencies: &latencies}),
+			)...)
+			is.Empty(t, err)
+
+			is.Empty(t, sel.Next(context.Background()))
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			// the selection is observed once, and does not include the execution's delay
+			is.Equal(t, 1, len(latencies))
+			is.True(t, latencies[0] < delay)
+		})
+	}
+
+	t.Run("EmptyExecutorsList", func(t *testing.T) {
+		var (
+			mu        sync.Mutex
+			latencies []time.Duration
+		)
+
+		sel := &blockingSelector{
+			logger:  slog.New(log.NoOp()),
+			metrics: selectLatencies{Metrics: metrics.NoOp(), mu: &mu, latencies: &latencies},
+			tracer:  noop.NewTracerProvider().Tracer("test"),
+		}
+
+		is.True(t, errors.Is(sel.Next(context.Background()), ErrEmptyExecutorsList))
+		is.Equal(t, 0, len(latencies))
+	})
+}
+
+type toggledExecutor struct {
+	countingExecutor
+
+	enabled bool
+	execs   *atomic.Int32
+}
+
+func (e toggledExecutor) Enabled() bool { return e.enabled }
+
+func (e toggledExecutor) Exec(context.Context) error {
+	e.execs.Add(1)
+
+	return nil
+}
+
+func TestDisabledExecutors(t *testing.T) {
+	now := time.Now()
+	calls := make([]int, 3)
+
+	t.Run("NotDue", func(t *testing.T) {
+		execs := []executor.Executor{
+			toggledExecutor{countingExecutor: countingExecutor{id: "0", at: now.Add(time.Second), calls: &calls[0]}},
+			toggledExecutor{
+				countingExecutor: countingExecutor{id: "1", at: now.Add(2 * time.Second), calls: &calls[1]},
+				enabled:          true,
+			},
+			countingExecutor{id: "2", at: now.Add(2 * time.Second), calls: &calls[2]},
+		}
+
+		selected := earliest(context.Background(), fixedClock{now: now}, execs)
+
+		is.Equal(t, 2, len(selected))
+		is.Equal(t, "1", selected[0].ID())
+		is.Equal(t, "2", selected[1].ID())
+	})
+
+	t.Run("AllDisabled", func(t *testing.T) {
+		var execs atomic.Int32
+
+		exec := toggledExecutor{
+			countingExecutor: countingExecutor{id: "0", at: time.Now().Add(100 * time.Millisecond), calls: &calls[0]},
+			execs:            &execs,
+		}
+
+		for _, opts := range [][]cfg.Option[*Config]{nil, {WithBlock()}} {
+			sel, err := New(append(opts, WithExecutors(exec), WithTimeout(time.Second))...)
+			is.Empty(t, err)
+
+			// a disabled executor is only executed (to register the skip) on its scheduled time, instead of spinning
+			start := time.Now()
+			ids, err := sel.NextSelected(context.Background())
+
+			is.Empty(t, err)
+			is.EqualElements(t, []string{"0"}, ids)
+			is.True(t, time.Since(start) >= 100*time.Millisecond-minStepDuration)
+			is.Equal(t, int32(1), execs.Load())
+
+			exec.at = time.Now().Add(100 * time.Millisecond)
+			execs.Store(0)
+		}
+	})
+}
+
+type delayedExecutor struct {
+	id    string
+	at    time.Time
+	delay time.Duration
+	err   error
+}
+
+func (e delayedExecutor) Exec(context.Context) error {
+	time.Sleep(e.delay)
+
+	return e.err
+}
+
+func (e delayedExecutor) RunNow(context.Context) error   { return nil }
+func (e delayedExecutor) Next(context.Context) time.Time { return e.at }
+func (e delayedExecutor) ID() string                     { return e.id }
+
+func TestWithCollectErrors(t *testing.T) {
+	fastErr := errors.New("fast error")
+	slowErr := errors.New("slow error")
+
+	for _, testcase := range []struct {
+		name   string
+		opts   []cfg.Option[*Config]
+		slow   time.Duration
+		errs   []error
+		noErrs []error
+	}{
+		{
+			name:   "Default/DetachesFromAll",
+			slow:   300 * time.Millisecond,
+			noErrs: []error{fastErr, slowErr},
+		},
+		{
+			name:   "Collect/WithinTimeout",
+			opts:   []cfg.Option[*Config]{WithCollectErrors()},
+			slow:   300 * time.Millisecond,
+			errs:   []error{fastErr},
+			noErrs: []error{slowErr},
+		},
+		{
+			name: "Collect/AllComplete",
+			opts: []cfg.Option[*Config]{WithCollectErrors()},
+			slow: 20 * time.Millisecond,
+			errs: []error{fastErr, slowErr},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			now := time.Now()
+
+			sel, err := New(append(testcase.opts,
+				WithExecutors(
+					delayedExecutor{id: "fast", at: now, err: fastErr},
+					delayedExecutor{id: "slow", at: now, delay: testcase.slow, err: slowErr},
+				),
+				WithTimeout(100*time.Millisecond),
+			)...)
+			is.Empty(t, err)
+
+			ids, err := sel.NextSelected(context.Background())
+			is.EqualElements(t, []string{"fast", "slow"}, ids)
+
+			for i := range testcase.errs {
+				is.True(t, errors.Is(err, testcase.errs[i]))
+			}
+
+			for i := range testcase.noErrs {
+				is.False(t, errors.Is(err, testcase.noErrs[i]))
+			}
+
+			is.Equal(t, 0, len(sel.(*selector).Drain(context.Background())))
+		})
+	}
+}
+
+// hungExecutor waits for its scheduled time and then runs for its delay, ignoring the context.Context's cancellation.
+type hungExecutor struct {
+	delayedExecutor
+}
+
+func (e hungExecutor) Exec(ctx context.Context) error {
+	if err := waitNext(ctx, realClock{}, e); err != nil {
+		return err
+	}
+
+	return e.delayedExecutor.Exec(ctx)
+}
+
+func TestBlockingSelector_WithTimeout(t *testing.T) {
+	for _, testcase := range []struct {
+		name  string
+		opts  []cfg.Option[*Config]
+		wait  time.Duration
+		delay time.Duration
+		err   error
+	}{
+		{
+			name:  "NoTimeout",
+			delay: 150 * time.Millisecond,
+		},
+		{
+			name:  "WithinTimeout",
+			opts:  []cfg.Option[*Config]{WithTimeout(100 * time.Millisecond)},
+			delay: 20 * time.Millisecond,
+		},
+		{
+			name:  "WithinTimeout/AfterWaiting",
+			opts:  []cfg.Option[*Config]{WithTimeout(100 * time.Millisecond)},
+			wait:  150 * time.Millisecond,
+			delay: 20 * time.Millisecond,
+		},
+		{
+			name:  "Exceeded",
+			opts:  []cfg.Option[*Config]{WithTimeout(50 * time.Millisecond)},
+			delay: 500 * time.Millisecond,
+			err:   context.DeadlineExceeded,
+		},
+		{
+			name:  "Priorities/Exceeded",
+			opts:  []cfg.Option[*Config]{WithTimeout(50 * time.Millisecond), WithPriorities(map[string]int{"hung": 1})},
+			delay: 500 * time.Millisecond,
+			err:   context.DeadlineExceeded,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			sel, err := New(append(testcase.opts,
+				WithBlock(),
+				WithExecutors(hungExecutor{delayedExecutor{
+					id:    "hung",
+					at:    time.Now().Add(testcase.wait),
+					delay: testcase.delay,
+				}}),
+			)...)
+			is.Empty(t, err)
+
+			start := time.Now()
+			err = sel.Next(context.Background())
+			is.True(t, errors.Is(err, testcase.err))
+
+			if testcase.err != nil {
+				is.True(t, time.Since(start) < testcase.delay)
+			}
+		})
+	}
+}
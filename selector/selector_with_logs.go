@@ -32,6 +32,10 @@ func AddLogs(s Selector, handler slog.Handler) Selector {
 	case *blockingSelector:
 		sel.logger = slog.New(handler)
 
+		return sel
+	case *prioritySelector:
+		sel.logger = slog.New(handler)
+
 		return sel
 	default:
 		return s
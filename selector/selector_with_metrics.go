@@ -10,7 +10,8 @@ import (
 //
 // If the input Selector is nil or a no-op Selector, a no-op Selector is returned.
 //
-// If the input Selector is a valid Selector, then its metrics collector is replaced with the input one.
+// If the input Selector is a valid Selector, then its metrics collector is replaced with the input one, which is
+// updated with the number of executor.Executor(s) currently registered in the Selector.
 //
 // Otherwise, the Selector is returned as-is.
 func AddMetrics(s Selector, m Metrics) Selector {
@@ -25,10 +26,17 @@ func AddMetrics(s Selector, m Metrics) Selector {
 	switch sel := s.(type) {
 	case *selector:
 		sel.metrics = m
+		m.ObserveRegisteredExecutors(len(sel.executors()))
 
 		return sel
 	case *blockingSelector:
 		sel.metrics = m
+		m.ObserveRegisteredExecutors(len(sel.executors()))
+
+		return sel
+	case *prioritySelector:
+		sel.metrics = m
+		m.ObserveRegisteredExecutors(len(sel.executors()))
 
 		return sel
 	default:
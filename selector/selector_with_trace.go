@@ -31,6 +31,10 @@ func AddTraces(s Selector, tracer trace.Tracer) Selector {
 	case *blockingSelector:
 		sel.tracer = tracer
 
+		return sel
+	case *prioritySelector:
+		sel.tracer = tracer
+
 		return sel
 	default:
 		return s
@@ -0,0 +1,53 @@
+package tracing
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+
+	"github.com/zalgonoise/cfg"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const (
+	schemeSeparator  = "://"
+	totalHTTPOptions = 3
+)
+
+// HTTPExporter creates a trace.SpanExporter using OTLP over HTTP to a tracing backend.
+//
+// The input url is either a host and port (like `localhost:4318`), or a full URL with a scheme and path (like
+// `https://collector:4318/v1/traces`). Similar to GRPCExporter, a host and port are reached with TLS if basic
+// authentication is configured, and in plain HTTP otherwise; while a full URL uses the security of its scheme.
+func HTTPExporter(url string, options ...cfg.Option[Config]) (sdktrace.SpanExporter, error) {
+	config := cfg.New(options...)
+
+	opts := make([]otlptracehttp.Option, 0, totalHTTPOptions)
+
+	switch {
+	case strings.Contains(url, schemeSeparator):
+		opts = append(opts, otlptracehttp.WithEndpointURL(url))
+	case config.username != "" && config.password != "":
+		opts = append(opts, otlptracehttp.WithEndpoint(url))
+	default:
+		opts = append(opts, otlptracehttp.WithEndpoint(url), otlptracehttp.WithInsecure())
+	}
+
+	if config.username != "" && config.password != "" {
+		opts = append(opts, otlptracehttp.WithHeaders(map[string]string{
+			authKey: "Basic " + base64.StdEncoding.EncodeToString([]byte(config.username+":"+config.password)),
+		}))
+	}
+
+	if config.timeout > 0 {
+		opts = append(opts, otlptracehttp.WithTimeout(config.timeout))
+	}
+
+	exporter, err := otlptracehttp.New(context.Background(), opts...)
+	if err != nil {
+		return noopExporter{}, err
+	}
+
+	return exporter, nil
+}
@@ -2,7 +2,9 @@ package tracing
 
 import (
 	"context"
+	"sync/atomic"
 
+	"github.com/zalgonoise/cfg"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/resource"
@@ -11,18 +13,47 @@ import (
 	"go.opentelemetry.io/otel/trace"
 )
 
+// ServiceName is the default service name, set in the traces' resource and used as the registered tracer's name.
 const ServiceName = "micron"
 
-// Tracer returns the registered tracer for this service. It defaults to a no-op trace.Tracer if not yet initialized.
+// serviceName holds the service name configured in the latest Init call.
+//
+//nolint:gochecknoglobals // process-wide state, like the global trace.TracerProvider that Init registers
+var serviceName atomic.Value
+
+// Tracer returns the registered tracer for this service, named after the service name configured in Init. It defaults
+// to a no-op trace.Tracer if not yet initialized.
 func Tracer() trace.Tracer {
-	return otel.GetTracerProvider().Tracer(ServiceName)
+	name, ok := serviceName.Load().(string)
+	if !ok {
+		name = ServiceName
+	}
+
+	return otel.GetTracerProvider().Tracer(name)
 }
 
 type ShutdownFunc func(ctx context.Context) error
 
-func Init(traceExporter sdktrace.SpanExporter) (ShutdownFunc, error) {
+// Init registers a global trace.TracerProvider exporting spans with the input sdktrace.SpanExporter, returning its
+// ShutdownFunc and an error if raised.
+//
+// All spans are sampled by default, which can be changed with the WithSampler option. The service name is ServiceName by
+// default, which can be changed with the WithServiceName option (e.g. to separate the traces of multiple instances).
+func Init(traceExporter sdktrace.SpanExporter, options ...cfg.Option[Config]) (ShutdownFunc, error) {
+	config := cfg.New(options...)
+
+	sampler := config.sampler
+	if sampler == nil {
+		sampler = sdktrace.AlwaysSample()
+	}
+
+	name := config.serviceName
+	if name == "" {
+		name = ServiceName
+	}
+
 	res, err := resource.New(context.Background(),
-		resource.WithAttributes(semconv.ServiceName(ServiceName)), // the service name used to display traces in backends
+		resource.WithAttributes(semconv.ServiceName(name)), // the service name used to display traces in backends
 	)
 	if err != nil {
 		return nil, err
@@ -32,13 +63,14 @@ func Init(traceExporter sdktrace.SpanExporter) (ShutdownFunc, error) {
 	// span processor to aggregate spans before export.
 	bsp := sdktrace.NewBatchSpanProcessor(traceExporter)
 	tracerProvider := sdktrace.NewTracerProvider(
-		sdktrace.WithSampler(sdktrace.AlwaysSample()),
+		sdktrace.WithSampler(sampler),
 		sdktrace.WithResource(res),
 		sdktrace.WithSpanProcessor(bsp),
 	)
 
-	// the tracer can now be referenced by the service name with a `otel.GetTracerProvider().Tracer(ServiceName)` call
+	// the tracer can now be referenced by the service name with a Tracer call
 	otel.SetTracerProvider(tracerProvider)
+	serviceName.Store(name)
 
 	// set global propagator to tracecontext (the default is no-op).
 	otel.SetTextMapPropagator(propagation.TraceContext{})
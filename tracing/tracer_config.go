@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/zalgonoise/cfg"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
 
 type Config struct {
@@ -11,6 +12,10 @@ type Config struct {
 
 	username string
 	password string
+
+	sampler sdktrace.Sampler
+
+	serviceName string
 }
 
 func WithTimeout(dur time.Duration) cfg.Option[Config] {
@@ -37,3 +42,33 @@ func WithBasicAuth(username, password string) cfg.Option[Config] {
 		return config
 	})
 }
+
+// WithSampler configures the sdktrace.Sampler used by the trace.TracerProvider registered with Init, which samples all
+// spans by default. For example, sdktrace.ParentBased(sdktrace.TraceIDRatioBased(0.1)) samples a tenth of the traces.
+func WithSampler(sampler sdktrace.Sampler) cfg.Option[Config] {
+	if sampler == nil {
+		return cfg.NoOp[Config]{}
+	}
+
+	return cfg.Register(func(config Config) Config {
+		config.sampler = sampler
+
+		return config
+	})
+}
+
+// WithServiceName sets the service name registered with Init, which is ServiceName by default. It is set as the
+// traces' service.name resource attribute, and names the tracer returned by Tracer.
+//
+// This call returns a cfg.NoOp cfg.Option if the input name is empty.
+func WithServiceName(name string) cfg.Option[Config] {
+	if name == "" {
+		return cfg.NoOp[Config]{}
+	}
+
+	return cfg.Register(func(config Config) Config {
+		config.serviceName = name
+
+		return config
+	})
+}
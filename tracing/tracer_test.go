@@ -2,10 +2,19 @@ package tracing
 
 import (
 	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/zalgonoise/cfg"
 	"github.com/zalgonoise/x/is"
+	"go.opentelemetry.io/otel"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.20.0"
 )
 
 func TestTracer(t *testing.T) {
@@ -62,3 +71,140 @@ func TestInit(t *testing.T) {
 		})
 	}
 }
+
+func TestInit_WithSampler(t *testing.T) {
+	for _, testcase := range []struct {
+		name    string
+		opts    []cfg.Option[Config]
+		sampled bool
+	}{
+		{
+			name:    "Default",
+			sampled: true,
+		},
+		{
+			name:    "NilSampler",
+			opts:    []cfg.Option[Config]{WithSampler(nil)},
+			sampled: true,
+		},
+		{
+			name: "NeverSample",
+			opts: []cfg.Option[Config]{WithSampler(sdktrace.NeverSample())},
+		},
+		{
+			name: "ParentBasedRatio",
+			opts: []cfg.Option[Config]{WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(0)))},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			ctx := context.Background()
+			done, err := Init(NoopExporter(), testcase.opts...)
+			is.Empty(t, err)
+
+			//nolint:errcheck // testing: we are sure noopTracer returns a nil error
+			defer done(ctx)
+
+			_, span := Tracer().Start(ctx, "span")
+			defer span.End()
+
+			is.Equal(t, testcase.sampled, span.SpanContext().IsSampled())
+		})
+	}
+}
+
+func TestHTTPExporter(t *testing.T) {
+	requests := make(chan *http.Request, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case requests <- r:
+		default:
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	for _, testcase := range []struct {
+		name string
+		url  string
+		opts []cfg.Option[Config]
+		auth string
+	}{
+		{
+			name: "HostAndPort",
+			url:  strings.TrimPrefix(server.URL, "http://"),
+		},
+		{
+			name: "FullURL/WithBasicAuth",
+			url:  server.URL + "/v1/traces",
+			opts: []cfg.Option[Config]{WithBasicAuth("user", "pass"), WithTimeout(time.Second)},
+			auth: "Basic " + base64.StdEncoding.EncodeToString([]byte("user:pass")),
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			exporter, err := HTTPExporter(testcase.url, testcase.opts...)
+			is.Empty(t, err)
+
+			provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+			_, span := provider.Tracer("test").Start(context.Background(), "span")
+			span.End()
+
+			is.Empty(t, provider.Shutdown(context.Background()))
+
+			r := <-requests
+			is.Equal(t, http.MethodPost, r.Method)
+			is.Equal(t, "/v1/traces", r.URL.Path)
+			is.Equal(t, testcase.auth, r.Header.Get(authKey))
+		})
+	}
+}
+
+func TestInit_WithServiceName(t *testing.T) {
+	for _, testcase := range []struct {
+		name  string
+		opts  []cfg.Option[Config]
+		wants string
+	}{
+		{
+			name:  "Default",
+			wants: ServiceName,
+		},
+		{
+			name:  "Empty",
+			opts:  []cfg.Option[Config]{WithServiceName("")},
+			wants: ServiceName,
+		},
+		{
+			name:  "Custom",
+			opts:  []cfg.Option[Config]{WithServiceName("tenant-a")},
+			wants: "tenant-a",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			exporter := tracetest.NewInMemoryExporter()
+
+			done, err := Init(exporter, testcase.opts...)
+			is.Empty(t, err)
+
+			_, span := Tracer().Start(context.Background(), "span")
+			span.End()
+
+			//nolint:errcheck // testing: the in-memory exporter returns a nil error
+			defer done(context.Background())
+
+			provider, ok := otel.GetTracerProvider().(*sdktrace.TracerProvider)
+			is.True(t, ok)
+			is.Empty(t, provider.ForceFlush(context.Background()))
+
+			spans := exporter.GetSpans()
+			is.Equal(t, 1, len(spans))
+			is.Equal(t, testcase.wants, spans[0].InstrumentationLibrary.Name)
+
+			value, ok := spans[0].Resource.Set().Value(semconv.ServiceNameKey)
+			is.True(t, ok)
+			is.Equal(t, testcase.wants, value.AsString())
+		})
+	}
+}